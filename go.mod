@@ -7,7 +7,7 @@ require (
 	github.com/YakDriver/go-version v0.1.0
 	github.com/YakDriver/regexache v0.23.0
 	github.com/aws/aws-sdk-go v1.53.15
-	github.com/aws/aws-sdk-go-v2 v1.27.1
+	github.com/aws/aws-sdk-go-v2 v1.30.3
 	github.com/aws/aws-sdk-go-v2/config v1.27.17
 	github.com/aws/aws-sdk-go-v2/credentials v1.17.17
 	github.com/aws/aws-sdk-go-v2/feature/ec2/imds v1.16.4
@@ -33,7 +33,7 @@ require (
 	github.com/aws/aws-sdk-go-v2/service/autoscalingplans v1.20.10
 	github.com/aws/aws-sdk-go-v2/service/batch v1.38.0
 	github.com/aws/aws-sdk-go-v2/service/bcmdataexports v1.3.9
-	github.com/aws/aws-sdk-go-v2/service/bedrock v1.8.6
+	github.com/aws/aws-sdk-go-v2/service/bedrock v1.12.0
 	github.com/aws/aws-sdk-go-v2/service/bedrockagent v1.12.1
 	github.com/aws/aws-sdk-go-v2/service/budgets v1.23.5
 	github.com/aws/aws-sdk-go-v2/service/chatbot v1.2.2
@@ -98,12 +98,15 @@ require (
 	github.com/aws/aws-sdk-go-v2/service/fms v1.33.6
 	github.com/aws/aws-sdk-go-v2/service/glacier v1.22.9
 	github.com/aws/aws-sdk-go-v2/service/globalaccelerator v1.23.6
+	github.com/aws/aws-sdk-go-v2/service/greengrassv2 v1.30.0
 	github.com/aws/aws-sdk-go-v2/service/groundstation v1.27.5
 	github.com/aws/aws-sdk-go-v2/service/healthlake v1.24.5
 	github.com/aws/aws-sdk-go-v2/service/iam v1.32.5
 	github.com/aws/aws-sdk-go-v2/service/identitystore v1.23.10
 	github.com/aws/aws-sdk-go-v2/service/inspector2 v1.26.5
 	github.com/aws/aws-sdk-go-v2/service/internetmonitor v1.14.5
+	github.com/aws/aws-sdk-go-v2/service/iotanalytics v1.24.3
+	github.com/aws/aws-sdk-go-v2/service/iotevents v1.25.3
 	github.com/aws/aws-sdk-go-v2/service/ivschat v1.12.10
 	github.com/aws/aws-sdk-go-v2/service/kafka v1.33.1
 	github.com/aws/aws-sdk-go-v2/service/kendra v1.50.6
@@ -177,7 +180,7 @@ require (
 	github.com/aws/aws-sdk-go-v2/service/timestreamwrite v1.25.10
 	github.com/aws/aws-sdk-go-v2/service/transcribe v1.37.5
 	github.com/aws/aws-sdk-go-v2/service/transfer v1.48.2
-	github.com/aws/aws-sdk-go-v2/service/verifiedpermissions v1.14.4
+	github.com/aws/aws-sdk-go-v2/service/verifiedpermissions v1.15.0
 	github.com/aws/aws-sdk-go-v2/service/vpclattice v1.8.4
 	github.com/aws/aws-sdk-go-v2/service/waf v1.20.9
 	github.com/aws/aws-sdk-go-v2/service/wafregional v1.21.9
@@ -186,7 +189,7 @@ require (
 	github.com/aws/aws-sdk-go-v2/service/workspaces v1.39.5
 	github.com/aws/aws-sdk-go-v2/service/workspacesweb v1.18.5
 	github.com/aws/aws-sdk-go-v2/service/xray v1.25.9
-	github.com/aws/smithy-go v1.20.2
+	github.com/aws/smithy-go v1.20.3
 	github.com/beevik/etree v1.4.0
 	github.com/cedar-policy/cedar-go v0.0.0-20240318205125-470d1fe984bb
 	github.com/davecgh/go-spew v1.1.1
@@ -229,6 +232,7 @@ require (
 	golang.org/x/tools v0.18.0
 	gopkg.in/dnaeon/go-vcr.v3 v3.2.0
 	gopkg.in/yaml.v2 v2.4.0
+	gopkg.in/yaml.v3 v3.0.1
 	syreclabs.com/go/faker v1.2.3
 )
 
@@ -240,8 +244,8 @@ require (
 	github.com/apparentlymart/go-textseg/v15 v15.0.0 // indirect
 	github.com/armon/go-radix v1.0.0 // indirect
 	github.com/aws/aws-sdk-go-v2/aws/protocol/eventstream v1.6.2 // indirect
-	github.com/aws/aws-sdk-go-v2/internal/configsources v1.3.8 // indirect
-	github.com/aws/aws-sdk-go-v2/internal/endpoints/v2 v2.6.8 // indirect
+	github.com/aws/aws-sdk-go-v2/internal/configsources v1.3.15 // indirect
+	github.com/aws/aws-sdk-go-v2/internal/endpoints/v2 v2.6.15 // indirect
 	github.com/aws/aws-sdk-go-v2/internal/ini v1.8.0 // indirect
 	github.com/aws/aws-sdk-go-v2/internal/v4a v1.3.8 // indirect
 	github.com/aws/aws-sdk-go-v2/service/internal/accept-encoding v1.11.2 // indirect
@@ -300,7 +304,6 @@ require (
 	google.golang.org/grpc v1.63.2 // indirect
 	google.golang.org/protobuf v1.34.0 // indirect
 	gopkg.in/check.v1 v1.0.0-20201130134442-10cb98267c6c // indirect
-	gopkg.in/yaml.v3 v3.0.1 // indirect
 )
 
 replace github.com/hashicorp/terraform-plugin-log => github.com/gdavison/terraform-plugin-log v0.0.0-20230928191232-6c653d8ef8fb
@@ -0,0 +1,44 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package json
+
+import (
+	"github.com/hashicorp/terraform-provider-aws/internal/json/ujson"
+)
+
+// KeyFirstLower converts the first character of each object key in a valid JSON string to lower case.
+// This maps the exported field names of AWS SDK for Go v2 API structures to AWS API member names.
+func KeyFirstLower(in []byte) []byte {
+	out := make([]byte, 0, len(in))
+
+	err := ujson.Walk(in, func(_ int, key, value []byte) bool {
+		// Write to output.
+		if len(out) != 0 && ujson.ShouldAddComma(value, out[len(out)-1]) {
+			out = append(out, ',')
+		}
+		// Keys include the surrounding quotes.
+		if len(key) > 2 {
+			out = append(out, key[0])
+			if c := key[1]; 'A' <= c && c <= 'Z' {
+				out = append(out, c+('a'-'A'))
+			} else {
+				out = append(out, c)
+			}
+			out = append(out, key[2:]...)
+			out = append(out, ':')
+		} else if len(key) > 0 {
+			out = append(out, key...)
+			out = append(out, ':')
+		}
+		out = append(out, value...)
+
+		return true
+	})
+
+	if err != nil {
+		return nil
+	}
+
+	return out
+}
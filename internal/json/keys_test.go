@@ -0,0 +1,55 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package json
+
+import (
+	"testing"
+)
+
+func TestKeyFirstLower(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		testName string
+		input    string
+		want     string
+	}{
+		{
+			testName: "empty JSON",
+			input:    "{}",
+			want:     "{}",
+		},
+		{
+			testName: "single field",
+			input:    `{ "Key": 42 }`,
+			want:     `{"key":42}`,
+		},
+		{
+			testName: "already lower case",
+			input:    `{"key": "Value"}`,
+			want:     `{"key":"Value"}`,
+		},
+		{
+			testName: "empty key",
+			input:    `{"": true}`,
+			want:     `{"":true}`,
+		},
+		{
+			testName: "nested objects and arrays",
+			input:    `{"InitialStateName": "A", "States": [{"StateName": "A", "OnEnter": {"Events": [{"EventName": "E", "Actions": [{"Sns": {"TargetArn": "Arn"}}, {"DynamoDBv2": {"TableName": "T"}}]}]}}]}`,
+			want:     `{"initialStateName":"A","states":[{"stateName":"A","onEnter":{"events":[{"eventName":"E","actions":[{"sns":{"targetArn":"Arn"}},{"dynamoDBv2":{"tableName":"T"}}]}]}}]}`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.testName, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := KeyFirstLower([]byte(testCase.input)), testCase.want; string(got) != want {
+				t.Errorf("KeyFirstLower(%q) = %q, want %q", testCase.input, got, want)
+			}
+		})
+	}
+}
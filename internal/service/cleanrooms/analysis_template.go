@@ -0,0 +1,395 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cleanrooms
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cleanrooms"
+	"github.com/aws/aws-sdk-go-v2/service/cleanrooms/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_cleanrooms_analysis_template")
+// @Tags(identifierAttribute="arn")
+func ResourceAnalysisTemplate() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceAnalysisTemplateCreate,
+		ReadWithoutTimeout:   resourceAnalysisTemplateRead,
+		UpdateWithoutTimeout: resourceAnalysisTemplateUpdate,
+		DeleteWithoutTimeout: resourceAnalysisTemplateDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(1 * time.Minute),
+			Update: schema.DefaultTimeout(1 * time.Minute),
+			Delete: schema.DefaultTimeout(1 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"analysis_parameters": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrDefaultValue: {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+						names.AttrName: {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						names.AttrType: {
+							Type:             schema.TypeString,
+							Required:         true,
+							ForceNew:         true,
+							ValidateDiagFunc: enum.Validate[types.ParameterType](),
+						},
+					},
+				},
+			},
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"collaboration_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"collaboration_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrCreateTime: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrDescription: {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			names.AttrFormat: {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: enum.Validate[types.AnalysisFormat](),
+			},
+			"membership_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"membership_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			names.AttrName: {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			names.AttrSchema: {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"referenced_tables": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			names.AttrSource: {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"text": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			"update_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+const (
+	ResNameAnalysisTemplate = "Analysis Template"
+)
+
+const (
+	analysisTemplateResourceIDPartCount = 2
+)
+
+func resourceAnalysisTemplateCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).CleanRoomsClient(ctx)
+
+	membershipID := d.Get("membership_id").(string)
+	name := d.Get(names.AttrName).(string)
+	input := &cleanrooms.CreateAnalysisTemplateInput{
+		Format:               types.AnalysisFormat(d.Get(names.AttrFormat).(string)),
+		MembershipIdentifier: aws.String(membershipID),
+		Name:                 aws.String(name),
+		Source:               expandAnalysisSource(d.Get(names.AttrSource).([]interface{})),
+		Tags:                 getTagsIn(ctx),
+	}
+
+	if v, ok := d.GetOk("analysis_parameters"); ok {
+		input.AnalysisParameters = expandAnalysisParameters(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk(names.AttrDescription); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	out, err := conn.CreateAnalysisTemplate(ctx, input)
+	if err != nil {
+		return create.AppendDiagError(diags, names.CleanRooms, create.ErrActionCreating, ResNameAnalysisTemplate, name, err)
+	}
+
+	if out == nil || out.AnalysisTemplate == nil {
+		return create.AppendDiagError(diags, names.CleanRooms, create.ErrActionCreating, ResNameAnalysisTemplate, name, errors.New("empty output"))
+	}
+
+	id, err := flex.FlattenResourceId([]string{membershipID, aws.ToString(out.AnalysisTemplate.Id)}, analysisTemplateResourceIDPartCount, false)
+	if err != nil {
+		return create.AppendDiagError(diags, names.CleanRooms, create.ErrActionCreating, ResNameAnalysisTemplate, name, err)
+	}
+	d.SetId(id)
+
+	return append(diags, resourceAnalysisTemplateRead(ctx, d, meta)...)
+}
+
+func resourceAnalysisTemplateRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).CleanRoomsClient(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), analysisTemplateResourceIDPartCount, false)
+	if err != nil {
+		return create.AppendDiagError(diags, names.CleanRooms, create.ErrActionReading, ResNameAnalysisTemplate, d.Id(), err)
+	}
+
+	out, err := findAnalysisTemplateByTwoPartKey(ctx, conn, parts[0], parts[1])
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Clean Rooms Analysis Template (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return create.AppendDiagError(diags, names.CleanRooms, create.ErrActionReading, ResNameAnalysisTemplate, d.Id(), err)
+	}
+
+	template := out.AnalysisTemplate
+	d.Set(names.AttrARN, template.Arn)
+	d.Set("collaboration_arn", template.CollaborationArn)
+	d.Set("collaboration_id", template.CollaborationId)
+	d.Set(names.AttrCreateTime, template.CreateTime.String())
+	d.Set(names.AttrDescription, template.Description)
+	d.Set(names.AttrFormat, template.Format)
+	d.Set("membership_arn", template.MembershipArn)
+	d.Set("membership_id", template.MembershipId)
+	d.Set(names.AttrName, template.Name)
+	d.Set("update_time", template.UpdateTime.String())
+
+	if err := d.Set("analysis_parameters", flattenAnalysisParameters(template.AnalysisParameters)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting analysis_parameters: %s", err)
+	}
+	if err := d.Set(names.AttrSchema, flattenAnalysisSchema(template.Schema)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting schema: %s", err)
+	}
+	if err := d.Set(names.AttrSource, flattenAnalysisSource(template.Source)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting source: %s", err)
+	}
+
+	return diags
+}
+
+func resourceAnalysisTemplateUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).CleanRoomsClient(ctx)
+
+	if d.HasChangesExcept(names.AttrTags, names.AttrTagsAll) {
+		parts, err := flex.ExpandResourceId(d.Id(), analysisTemplateResourceIDPartCount, false)
+		if err != nil {
+			return create.AppendDiagError(diags, names.CleanRooms, create.ErrActionUpdating, ResNameAnalysisTemplate, d.Id(), err)
+		}
+
+		input := &cleanrooms.UpdateAnalysisTemplateInput{
+			AnalysisTemplateIdentifier: aws.String(parts[1]),
+			Description:                aws.String(d.Get(names.AttrDescription).(string)),
+			MembershipIdentifier:       aws.String(parts[0]),
+		}
+
+		_, err = conn.UpdateAnalysisTemplate(ctx, input)
+		if err != nil {
+			return create.AppendDiagError(diags, names.CleanRooms, create.ErrActionUpdating, ResNameAnalysisTemplate, d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceAnalysisTemplateRead(ctx, d, meta)...)
+}
+
+func resourceAnalysisTemplateDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).CleanRoomsClient(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), analysisTemplateResourceIDPartCount, false)
+	if err != nil {
+		return create.AppendDiagError(diags, names.CleanRooms, create.ErrActionDeleting, ResNameAnalysisTemplate, d.Id(), err)
+	}
+
+	log.Printf("[INFO] Deleting Clean Rooms Analysis Template %s", d.Id())
+	_, err = conn.DeleteAnalysisTemplate(ctx, &cleanrooms.DeleteAnalysisTemplateInput{
+		AnalysisTemplateIdentifier: aws.String(parts[1]),
+		MembershipIdentifier:       aws.String(parts[0]),
+	})
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return create.AppendDiagError(diags, names.CleanRooms, create.ErrActionDeleting, ResNameAnalysisTemplate, d.Id(), err)
+	}
+
+	return diags
+}
+
+func findAnalysisTemplateByTwoPartKey(ctx context.Context, conn *cleanrooms.Client, membershipID, id string) (*cleanrooms.GetAnalysisTemplateOutput, error) {
+	in := &cleanrooms.GetAnalysisTemplateInput{
+		AnalysisTemplateIdentifier: aws.String(id),
+		MembershipIdentifier:       aws.String(membershipID),
+	}
+
+	out, err := conn.GetAnalysisTemplate(ctx, in)
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: in,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if out == nil || out.AnalysisTemplate == nil {
+		return nil, tfresource.NewEmptyResultError(in)
+	}
+
+	return out, nil
+}
+
+func expandAnalysisSource(data []interface{}) types.AnalysisSource {
+	source := data[0].(map[string]interface{})
+	return &types.AnalysisSourceMemberText{
+		Value: source["text"].(string),
+	}
+}
+
+func expandAnalysisParameters(tfList []interface{}) []types.AnalysisParameter {
+	var apiObjects []types.AnalysisParameter
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		apiObject := types.AnalysisParameter{
+			Name: aws.String(tfMap[names.AttrName].(string)),
+			Type: types.ParameterType(tfMap[names.AttrType].(string)),
+		}
+
+		if v, ok := tfMap[names.AttrDefaultValue].(string); ok && v != "" {
+			apiObject.DefaultValue = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenAnalysisSource(source types.AnalysisSource) []interface{} {
+	switch v := source.(type) {
+	case *types.AnalysisSourceMemberText:
+		m := map[string]interface{}{
+			"text": v.Value,
+		}
+		return []interface{}{m}
+	default:
+		return nil
+	}
+}
+
+func flattenAnalysisParameters(apiObjects []types.AnalysisParameter) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, map[string]interface{}{
+			names.AttrDefaultValue: aws.ToString(apiObject.DefaultValue),
+			names.AttrName:         aws.ToString(apiObject.Name),
+			names.AttrType:         string(apiObject.Type),
+		})
+	}
+
+	return tfList
+}
+
+func flattenAnalysisSchema(apiObject *types.AnalysisSchema) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"referenced_tables": flex.FlattenStringValueList(apiObject.ReferencedTables),
+		},
+	}
+}
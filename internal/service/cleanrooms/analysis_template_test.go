@@ -0,0 +1,184 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cleanrooms_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cleanrooms"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tfcleanrooms "github.com/hashicorp/terraform-provider-aws/internal/service/cleanrooms"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccCleanRoomsAnalysisTemplate_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	var template cleanrooms.GetAnalysisTemplateOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_cleanrooms_analysis_template.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CleanRoomsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAnalysisTemplateDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAnalysisTemplateConfig_basic(rName, "description"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAnalysisTemplateExists(ctx, resourceName, &template),
+					resource.TestCheckResourceAttr(resourceName, "analysis_parameters.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "analysis_parameters.0.name", "minimum"),
+					resource.TestCheckResourceAttr(resourceName, "analysis_parameters.0.type", "INTEGER"),
+					resource.TestCheckResourceAttr(resourceName, "analysis_parameters.0.default_value", "10"),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrARN),
+					resource.TestCheckResourceAttrPair(resourceName, "collaboration_id", "aws_cleanrooms_collaboration.test", names.AttrID),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, "description"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrFormat, "SQL"),
+					resource.TestCheckResourceAttrPair(resourceName, "membership_id", "aws_cleanrooms_membership.test", names.AttrID),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+					resource.TestCheckResourceAttr(resourceName, "schema.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "source.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "tags.Project", TEST_TAG),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAnalysisTemplateConfig_basic(rName, "updated description"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAnalysisTemplateExists(ctx, resourceName, &template),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, "updated description"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCleanRoomsAnalysisTemplate_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	var template cleanrooms.GetAnalysisTemplateOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_cleanrooms_analysis_template.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CleanRoomsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAnalysisTemplateDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAnalysisTemplateConfig_basic(rName, "description"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAnalysisTemplateExists(ctx, resourceName, &template),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfcleanrooms.ResourceAnalysisTemplate(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAnalysisTemplateDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).CleanRoomsClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_cleanrooms_analysis_template" {
+				continue
+			}
+
+			parts, err := flex.ExpandResourceId(rs.Primary.ID, 2, false)
+			if err != nil {
+				return err
+			}
+
+			_, err = conn.GetAnalysisTemplate(ctx, &cleanrooms.GetAnalysisTemplateInput{
+				AnalysisTemplateIdentifier: aws.String(parts[1]),
+				MembershipIdentifier:       aws.String(parts[0]),
+			})
+
+			if err == nil {
+				return create.Error(names.CleanRooms, create.ErrActionCheckingExistence, tfcleanrooms.ResNameAnalysisTemplate, rs.Primary.ID, errors.New("not destroyed"))
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckAnalysisTemplateExists(ctx context.Context, name string, template *cleanrooms.GetAnalysisTemplateOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return create.Error(names.CleanRooms, create.ErrActionCheckingExistence, tfcleanrooms.ResNameAnalysisTemplate, name, errors.New("not found"))
+		}
+
+		if rs.Primary.ID == "" {
+			return create.Error(names.CleanRooms, create.ErrActionCheckingExistence, tfcleanrooms.ResNameAnalysisTemplate, name, errors.New("not set"))
+		}
+
+		parts, err := flex.ExpandResourceId(rs.Primary.ID, 2, false)
+		if err != nil {
+			return err
+		}
+
+		client := acctest.Provider.Meta().(*conns.AWSClient).CleanRoomsClient(ctx)
+		resp, err := client.GetAnalysisTemplate(ctx, &cleanrooms.GetAnalysisTemplateInput{
+			AnalysisTemplateIdentifier: aws.String(parts[1]),
+			MembershipIdentifier:       aws.String(parts[0]),
+		})
+
+		if err != nil {
+			return create.Error(names.CleanRooms, create.ErrActionCheckingExistence, tfcleanrooms.ResNameAnalysisTemplate, rs.Primary.ID, err)
+		}
+
+		*template = *resp
+
+		return nil
+	}
+}
+
+func testAccAnalysisTemplateConfig_basic(rName, description string) string {
+	return acctest.ConfigCompose(testAccConfiguredTableAssociationConfig_basic(rName, TEST_DESCRIPTION), fmt.Sprintf(`
+resource "aws_cleanrooms_analysis_template" "test" {
+  name          = %[1]q
+  description   = %[2]q
+  membership_id = aws_cleanrooms_membership.test.id
+  format        = "SQL"
+
+  source {
+    text = "SELECT my_column_1, COUNT(DISTINCT my_column_2) FROM test_table GROUP BY my_column_1 HAVING COUNT(DISTINCT my_column_2) >= :minimum"
+  }
+
+  analysis_parameters {
+    name          = "minimum"
+    type          = "INTEGER"
+    default_value = "10"
+  }
+
+  tags = {
+    Project = "Terraform"
+  }
+
+  depends_on = [aws_cleanrooms_configured_table_association.test]
+}
+`, rName, description))
+}
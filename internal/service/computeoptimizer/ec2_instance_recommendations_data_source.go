@@ -0,0 +1,166 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package computeoptimizer
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/computeoptimizer"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/computeoptimizer/types"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource("aws_computeoptimizer_ec2_instance_recommendations", name="EC2 Instance Recommendations")
+func newEC2InstanceRecommendationsDataSource(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &ec2InstanceRecommendationsDataSource{}, nil
+}
+
+type ec2InstanceRecommendationsDataSource struct {
+	framework.DataSourceWithConfigure
+}
+
+func (*ec2InstanceRecommendationsDataSource) Metadata(_ context.Context, request datasource.MetadataRequest, response *datasource.MetadataResponse) {
+	response.TypeName = "aws_computeoptimizer_ec2_instance_recommendations"
+}
+
+func (d *ec2InstanceRecommendationsDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"account_ids": schema.SetAttribute{
+				CustomType:  fwtypes.SetOfStringType,
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"instance_arns": schema.SetAttribute{
+				CustomType:  fwtypes.SetOfStringType,
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"instance_recommendations": schema.ListAttribute{
+				CustomType:  fwtypes.NewListNestedObjectTypeOf[instanceRecommendationModel](ctx),
+				ElementType: fwtypes.NewObjectTypeOf[instanceRecommendationModel](ctx),
+				Computed:    true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			names.AttrFilter: schema.SetNestedBlock{
+				CustomType: fwtypes.NewSetNestedObjectTypeOf[filterModel](ctx),
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						names.AttrName: schema.StringAttribute{
+							CustomType: fwtypes.StringEnumType[awstypes.FilterName](),
+							Required:   true,
+						},
+						names.AttrValues: schema.SetAttribute{
+							CustomType:  fwtypes.SetOfStringType,
+							ElementType: types.StringType,
+							Required:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *ec2InstanceRecommendationsDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data ec2InstanceRecommendationsDataSourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := d.Meta().ComputeOptimizerClient(ctx)
+
+	input := &computeoptimizer.GetEC2InstanceRecommendationsInput{}
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	output, err := findEC2InstanceRecommendations(ctx, conn, input)
+
+	if err != nil {
+		response.Diagnostics.AddError("reading Compute Optimizer EC2 Instance Recommendations", err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, &computeoptimizer.GetEC2InstanceRecommendationsOutput{InstanceRecommendations: output}, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func findEC2InstanceRecommendations(ctx context.Context, conn *computeoptimizer.Client, input *computeoptimizer.GetEC2InstanceRecommendationsInput) ([]awstypes.InstanceRecommendation, error) {
+	var output []awstypes.InstanceRecommendation
+
+	for {
+		page, err := conn.GetEC2InstanceRecommendations(ctx, input)
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.InstanceRecommendations...)
+
+		if aws.ToString(page.NextToken) == "" {
+			break
+		}
+
+		input.NextToken = page.NextToken
+	}
+
+	return output, nil
+}
+
+type ec2InstanceRecommendationsDataSourceModel struct {
+	AccountIDs              fwtypes.SetValueOf[types.String]                             `tfsdk:"account_ids"`
+	Filters                 fwtypes.SetNestedObjectValueOf[filterModel]                  `tfsdk:"filter"`
+	InstanceARNs            fwtypes.SetValueOf[types.String]                             `tfsdk:"instance_arns"`
+	InstanceRecommendations fwtypes.ListNestedObjectValueOf[instanceRecommendationModel] `tfsdk:"instance_recommendations"`
+}
+
+type filterModel struct {
+	Name   fwtypes.StringEnum[awstypes.FilterName] `tfsdk:"name"`
+	Values fwtypes.SetValueOf[types.String]        `tfsdk:"values"`
+}
+
+type instanceRecommendationModel struct {
+	AccountID              types.String                                                       `tfsdk:"account_id"`
+	CurrentInstanceType    types.String                                                       `tfsdk:"current_instance_type"`
+	CurrentPerformanceRisk types.String                                                       `tfsdk:"current_performance_risk"`
+	Finding                types.String                                                       `tfsdk:"finding"`
+	FindingReasonCodes     fwtypes.ListValueOf[types.String]                                  `tfsdk:"finding_reason_codes"`
+	InstanceARN            types.String                                                       `tfsdk:"instance_arn"`
+	InstanceName           types.String                                                       `tfsdk:"instance_name"`
+	InstanceState          types.String                                                       `tfsdk:"instance_state"`
+	LastRefreshTimestamp   timetypes.RFC3339                                                  `tfsdk:"last_refresh_timestamp"`
+	LookBackPeriodInDays   types.Float64                                                      `tfsdk:"look_back_period_in_days"`
+	RecommendationOptions  fwtypes.ListNestedObjectValueOf[instanceRecommendationOptionModel] `tfsdk:"recommendation_options"`
+	UtilizationMetrics     fwtypes.ListNestedObjectValueOf[utilizationMetricModel]            `tfsdk:"utilization_metrics"`
+}
+
+type instanceRecommendationOptionModel struct {
+	InstanceType    types.String  `tfsdk:"instance_type"`
+	MigrationEffort types.String  `tfsdk:"migration_effort"`
+	PerformanceRisk types.Float64 `tfsdk:"performance_risk"`
+	Rank            types.Int64   `tfsdk:"rank"`
+}
+
+type utilizationMetricModel struct {
+	Name      types.String  `tfsdk:"name"`
+	Statistic types.String  `tfsdk:"statistic"`
+	Value     types.Float64 `tfsdk:"value"`
+}
@@ -0,0 +1,69 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package computeoptimizer_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func testAccEC2InstanceRecommendationsDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_computeoptimizer_ec2_instance_recommendations.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ComputeOptimizerServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEC2InstanceRecommendationsDataSourceConfig_basic,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "instance_recommendations.#"),
+				),
+			},
+		},
+	})
+}
+
+func testAccEC2InstanceRecommendationsDataSource_filter(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_computeoptimizer_ec2_instance_recommendations.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ComputeOptimizerServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEC2InstanceRecommendationsDataSourceConfig_filter,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "account_ids.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(dataSourceName, "filter.#", acctest.Ct1),
+					resource.TestCheckResourceAttrSet(dataSourceName, "instance_recommendations.#"),
+				),
+			},
+		},
+	})
+}
+
+const testAccEC2InstanceRecommendationsDataSourceConfig_basic = `
+data "aws_computeoptimizer_ec2_instance_recommendations" "test" {}
+`
+
+const testAccEC2InstanceRecommendationsDataSourceConfig_filter = `
+data "aws_caller_identity" "current" {}
+
+data "aws_computeoptimizer_ec2_instance_recommendations" "test" {
+  account_ids = [data.aws_caller_identity.current.account_id]
+
+  filter {
+    name   = "Finding"
+    values = ["Overprovisioned", "Underprovisioned"]
+  }
+}
+`
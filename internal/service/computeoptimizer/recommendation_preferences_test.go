@@ -0,0 +1,271 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package computeoptimizer_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfcomputeoptimizer "github.com/hashicorp/terraform-provider-aws/internal/service/computeoptimizer"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func testAccRecommendationPreferences_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_computeoptimizer_recommendation_preferences.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ComputeOptimizerServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRecommendationPreferencesDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRecommendationPreferencesConfig_basic("DAYS_32", "Datadog"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckRecommendationPreferencesExists(ctx, resourceName),
+					resource.TestCheckNoResourceAttr(resourceName, "enhanced_infrastructure_metrics"),
+					resource.TestCheckResourceAttr(resourceName, "external_metrics_preference.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "external_metrics_preference.0.source", "Datadog"),
+					resource.TestCheckNoResourceAttr(resourceName, "inferred_workload_types"),
+					resource.TestCheckResourceAttr(resourceName, "look_back_period", "DAYS_32"),
+					resource.TestCheckResourceAttr(resourceName, "preferred_resource.#", acctest.Ct0),
+					resource.TestCheckResourceAttr(resourceName, names.AttrResourceType, "Ec2Instance"),
+					resource.TestCheckResourceAttr(resourceName, "scope.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "scope.0.name", "AccountId"),
+					acctest.CheckResourceAttrAccountID(resourceName, "scope.0.value"),
+					resource.TestCheckResourceAttr(resourceName, "utilization_preference.#", acctest.Ct0),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccRecommendationPreferencesConfig_basic("DAYS_93", "Dynatrace"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckRecommendationPreferencesExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "external_metrics_preference.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "external_metrics_preference.0.source", "Dynatrace"),
+					resource.TestCheckResourceAttr(resourceName, "look_back_period", "DAYS_93"),
+				),
+			},
+		},
+	})
+}
+
+func testAccRecommendationPreferences_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_computeoptimizer_recommendation_preferences.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ComputeOptimizerServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRecommendationPreferencesDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRecommendationPreferencesConfig_basic("DAYS_32", "Datadog"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckRecommendationPreferencesExists(ctx, resourceName),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfcomputeoptimizer.ResourceRecommendationPreferences, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccRecommendationPreferences_preferredResources(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_computeoptimizer_recommendation_preferences.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ComputeOptimizerServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRecommendationPreferencesDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRecommendationPreferencesConfig_preferredResources,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckRecommendationPreferencesExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "enhanced_infrastructure_metrics", "Active"),
+					resource.TestCheckResourceAttr(resourceName, "inferred_workload_types", "Active"),
+					resource.TestCheckResourceAttr(resourceName, "preferred_resource.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "preferred_resource.0.include_list.#", acctest.Ct2),
+					resource.TestCheckTypeSetElemAttr(resourceName, "preferred_resource.0.include_list.*", "m5.xlarge"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "preferred_resource.0.include_list.*", "r5"),
+					resource.TestCheckResourceAttr(resourceName, "preferred_resource.0.name", "Ec2InstanceTypes"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrResourceType, "Ec2Instance"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccRecommendationPreferences_utilizationPreferences(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_computeoptimizer_recommendation_preferences.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckOrganizationManagementAccount(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.ComputeOptimizerServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRecommendationPreferencesDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRecommendationPreferencesConfig_utilizationPreferences,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckRecommendationPreferencesExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "enhanced_infrastructure_metrics", "Active"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrResourceType, "Ec2Instance"),
+					resource.TestCheckResourceAttr(resourceName, "scope.0.name", "Organization"),
+					resource.TestCheckResourceAttr(resourceName, "utilization_preference.#", acctest.Ct2),
+					resource.TestCheckResourceAttr(resourceName, "utilization_preference.0.metric_name", "CpuUtilization"),
+					resource.TestCheckResourceAttr(resourceName, "utilization_preference.0.metric_parameters.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "utilization_preference.0.metric_parameters.0.headroom", "PERCENT_20"),
+					resource.TestCheckResourceAttr(resourceName, "utilization_preference.0.metric_parameters.0.threshold", "P95"),
+					resource.TestCheckResourceAttr(resourceName, "utilization_preference.1.metric_name", "MemoryUtilization"),
+					resource.TestCheckResourceAttr(resourceName, "utilization_preference.1.metric_parameters.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "utilization_preference.1.metric_parameters.0.headroom", "PERCENT_30"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckRecommendationPreferencesDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ComputeOptimizerClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_computeoptimizer_recommendation_preferences" {
+				continue
+			}
+
+			_, err := tfcomputeoptimizer.FindRecommendationPreferencesByThreePartKey(ctx, conn, rs.Primary.Attributes[names.AttrResourceType], rs.Primary.Attributes["scope.0.name"], rs.Primary.Attributes["scope.0.value"])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Compute Optimizer Recommendation Preferences %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckRecommendationPreferencesExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ComputeOptimizerClient(ctx)
+
+		_, err := tfcomputeoptimizer.FindRecommendationPreferencesByThreePartKey(ctx, conn, rs.Primary.Attributes[names.AttrResourceType], rs.Primary.Attributes["scope.0.name"], rs.Primary.Attributes["scope.0.value"])
+
+		return err
+	}
+}
+
+func testAccRecommendationPreferencesConfig_basic(lookBackPeriod, source string) string {
+	return fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+resource "aws_computeoptimizer_recommendation_preferences" "test" {
+  resource_type = "Ec2Instance"
+
+  scope {
+    name  = "AccountId"
+    value = data.aws_caller_identity.current.account_id
+  }
+
+  look_back_period = %[1]q
+
+  external_metrics_preference {
+    source = %[2]q
+  }
+}
+`, lookBackPeriod, source)
+}
+
+const testAccRecommendationPreferencesConfig_preferredResources = `
+data "aws_caller_identity" "current" {}
+
+resource "aws_computeoptimizer_recommendation_preferences" "test" {
+  resource_type = "Ec2Instance"
+
+  scope {
+    name  = "AccountId"
+    value = data.aws_caller_identity.current.account_id
+  }
+
+  enhanced_infrastructure_metrics = "Active"
+  inferred_workload_types         = "Active"
+
+  preferred_resource {
+    include_list = ["m5.xlarge", "r5"]
+    name         = "Ec2InstanceTypes"
+  }
+}
+`
+
+const testAccRecommendationPreferencesConfig_utilizationPreferences = `
+resource "aws_computeoptimizer_recommendation_preferences" "test" {
+  resource_type = "Ec2Instance"
+
+  scope {
+    name  = "Organization"
+    value = "ALL_ACCOUNTS"
+  }
+
+  enhanced_infrastructure_metrics = "Active"
+
+  utilization_preference {
+    metric_name = "CpuUtilization"
+
+    metric_parameters {
+      headroom  = "PERCENT_20"
+      threshold = "P95"
+    }
+  }
+
+  utilization_preference {
+    metric_name = "MemoryUtilization"
+
+    metric_parameters {
+      headroom = "PERCENT_30"
+    }
+  }
+}
+`
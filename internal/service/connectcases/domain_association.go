@@ -0,0 +1,233 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package connectcases
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/connect"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_connectcases_domain_association", name="Domain Association")
+func newDomainAssociationResource(context.Context) (resource.ResourceWithConfigure, error) {
+	return &domainAssociationResource{}, nil
+}
+
+type domainAssociationResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+	framework.WithNoUpdate
+}
+
+func (*domainAssociationResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_connectcases_domain_association"
+}
+
+func (r *domainAssociationResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"association_arn": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"association_id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"domain_arn": schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Required:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			names.AttrInstanceID: schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+func (r *domainAssociationResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data domainAssociationResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Cases domains are associated with Connect instances via the Connect API.
+	conn := r.Meta().ConnectConn(ctx)
+
+	input := &connect.CreateIntegrationAssociationInput{
+		InstanceId:      aws.String(data.InstanceID.ValueString()),
+		IntegrationArn:  aws.String(data.DomainARN.ValueString()),
+		IntegrationType: aws.String(connect.IntegrationTypeCasesDomain),
+	}
+
+	output, err := conn.CreateIntegrationAssociationWithContext(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating Connect Cases Domain Association (%s/%s)", data.DomainARN.ValueString(), data.InstanceID.ValueString()), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.AssociationARN = fwflex.StringToFramework(ctx, output.IntegrationAssociationArn)
+	data.AssociationID = fwflex.StringToFramework(ctx, output.IntegrationAssociationId)
+	data.setID()
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *domainAssociationResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data domainAssociationResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if err := data.InitFromID(); err != nil {
+		response.Diagnostics.AddError("parsing resource ID", err.Error())
+
+		return
+	}
+
+	conn := r.Meta().ConnectConn(ctx)
+
+	output, err := findDomainAssociationByTwoPartKey(ctx, conn, data.InstanceID.ValueString(), data.AssociationID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Connect Cases Domain Association (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	data.AssociationARN = fwflex.StringToFramework(ctx, output.IntegrationAssociationArn)
+	data.DomainARN = fwtypes.ARNValue(aws.StringValue(output.IntegrationArn))
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *domainAssociationResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data domainAssociationResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().ConnectConn(ctx)
+
+	_, err := conn.DeleteIntegrationAssociationWithContext(ctx, &connect.DeleteIntegrationAssociationInput{
+		InstanceId:               aws.String(data.InstanceID.ValueString()),
+		IntegrationAssociationId: aws.String(data.AssociationID.ValueString()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, connect.ErrCodeResourceNotFoundException) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Connect Cases Domain Association (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func findDomainAssociationByTwoPartKey(ctx context.Context, conn *connect.Connect, instanceID, associationID string) (*connect.IntegrationAssociationSummary, error) {
+	input := &connect.ListIntegrationAssociationsInput{
+		InstanceId:      aws.String(instanceID),
+		IntegrationType: aws.String(connect.IntegrationTypeCasesDomain),
+	}
+	var output []*connect.IntegrationAssociationSummary
+
+	err := conn.ListIntegrationAssociationsPagesWithContext(ctx, input, func(page *connect.ListIntegrationAssociationsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.IntegrationAssociationSummaryList {
+			if v != nil && aws.StringValue(v.IntegrationAssociationId) == associationID {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, connect.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfresource.AssertSinglePtrResult(output)
+}
+
+type domainAssociationResourceModel struct {
+	AssociationARN types.String `tfsdk:"association_arn"`
+	AssociationID  types.String `tfsdk:"association_id"`
+	DomainARN      fwtypes.ARN  `tfsdk:"domain_arn"`
+	ID             types.String `tfsdk:"id"`
+	InstanceID     types.String `tfsdk:"instance_id"`
+}
+
+const (
+	domainAssociationResourceIDPartCount = 2
+)
+
+func (data *domainAssociationResourceModel) InitFromID() error {
+	id := data.ID.ValueString()
+	parts, err := flex.ExpandResourceId(id, domainAssociationResourceIDPartCount, false)
+
+	if err != nil {
+		return err
+	}
+
+	data.InstanceID = types.StringValue(parts[0])
+	data.AssociationID = types.StringValue(parts[1])
+
+	return nil
+}
+
+func (data *domainAssociationResourceModel) setID() {
+	data.ID = types.StringValue(errs.Must(flex.FlattenResourceId([]string{data.InstanceID.ValueString(), data.AssociationID.ValueString()}, domainAssociationResourceIDPartCount, false)))
+}
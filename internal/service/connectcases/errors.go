@@ -0,0 +1,9 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package connectcases
+
+const (
+	// Error codes returned in BatchGetField errors.
+	errCodeResourceNotFound = "ResourceNotFoundException"
+)
@@ -0,0 +1,19 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package connectcases
+
+// Exports for use in tests only.
+var (
+	ResourceDomain            = newDomainResource
+	ResourceDomainAssociation = newDomainAssociationResource
+	ResourceField             = newFieldResource
+	ResourceLayout            = newLayoutResource
+	ResourceTemplate          = newTemplateResource
+
+	FindDomainAssociationByTwoPartKey = findDomainAssociationByTwoPartKey
+	FindDomainByID                    = findDomainByID
+	FindFieldByTwoPartKey             = findFieldByTwoPartKey
+	FindLayoutByTwoPartKey            = findLayoutByTwoPartKey
+	FindTemplateByTwoPartKey          = findTemplateByTwoPartKey
+)
@@ -0,0 +1,539 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package connectcases
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/connectcases"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/connectcases/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_connectcases_field", name="Field")
+// @Tags(identifierAttribute="arn")
+func newFieldResource(context.Context) (resource.ResourceWithConfigure, error) {
+	return &fieldResource{}, nil
+}
+
+type fieldResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+}
+
+func (*fieldResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_connectcases_field"
+}
+
+func (r *fieldResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			names.AttrDescription: schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(0, 255),
+				},
+			},
+			"domain_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"field_id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 100),
+				},
+			},
+			names.AttrNamespace: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.FieldNamespace](),
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+			names.AttrType: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.FieldType](),
+				Required:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"option": schema.SetNestedBlock{
+				CustomType: fwtypes.NewSetNestedObjectTypeOf[fieldOptionModel](ctx),
+				Validators: []validator.Set{
+					setvalidator.SizeAtMost(fieldOptionsMaxItems),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"active": schema.BoolAttribute{
+							Optional: true,
+							Computed: true,
+							Default:  booldefault.StaticBool(true),
+						},
+						names.AttrName: schema.StringAttribute{
+							Required: true,
+							Validators: []validator.String{
+								stringvalidator.LengthBetween(1, 100),
+							},
+						},
+						names.AttrValue: schema.StringAttribute{
+							Required: true,
+							Validators: []validator.String{
+								stringvalidator.LengthBetween(1, 100),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *fieldResource) ValidateConfig(ctx context.Context, request resource.ValidateConfigRequest, response *resource.ValidateConfigResponse) {
+	var data fieldResourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Type.IsUnknown() || data.Options.IsUnknown() {
+		return
+	}
+
+	if len(data.Options.Elements()) > 0 && data.Type.ValueEnum() != awstypes.FieldTypeSingleSelect {
+		response.Diagnostics.AddAttributeError(
+			path.Root("option"),
+			"Invalid Attribute Combination",
+			fmt.Sprintf("option can only be configured when type is %q", awstypes.FieldTypeSingleSelect),
+		)
+	}
+}
+
+func (r *fieldResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data fieldResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().ConnectCasesClient(ctx)
+
+	input := &connectcases.CreateFieldInput{}
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	output, err := conn.CreateField(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating Connect Cases Field (%s)", data.Name.ValueString()), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.FieldARN = fwflex.StringToFramework(ctx, output.FieldArn)
+	data.FieldID = fwflex.StringToFramework(ctx, output.FieldId)
+	data.setID()
+
+	// Fields can't be tagged on creation.
+	if err := createTags(ctx, conn, data.FieldARN.ValueString(), getTagsIn(ctx)); err != nil {
+		response.State.SetAttribute(ctx, path.Root(names.AttrID), data.ID) // Set 'id' so as to taint the resource.
+		response.Diagnostics.AddError(fmt.Sprintf("setting Connect Cases Field (%s) tags", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	if !data.Options.IsNull() {
+		var options []awstypes.FieldOption
+		response.Diagnostics.Append(fwflex.Expand(ctx, data.Options, &options)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		if err := putFieldOptions(ctx, conn, data.DomainID.ValueString(), data.FieldID.ValueString(), options); err != nil {
+			response.State.SetAttribute(ctx, path.Root(names.AttrID), data.ID) // Set 'id' so as to taint the resource.
+			response.Diagnostics.AddError(fmt.Sprintf("putting Connect Cases Field (%s) options", data.ID.ValueString()), err.Error())
+
+			return
+		}
+	}
+
+	field, err := findFieldByTwoPartKey(ctx, conn, data.DomainID.ValueString(), data.FieldID.ValueString())
+
+	if err != nil {
+		response.State.SetAttribute(ctx, path.Root(names.AttrID), data.ID) // Set 'id' so as to taint the resource.
+		response.Diagnostics.AddError(fmt.Sprintf("reading Connect Cases Field (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	data.Namespace = fwtypes.StringEnumValue(field.Namespace)
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *fieldResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data fieldResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if err := data.InitFromID(); err != nil {
+		response.Diagnostics.AddError("parsing resource ID", err.Error())
+
+		return
+	}
+
+	conn := r.Meta().ConnectCasesClient(ctx)
+
+	output, err := findFieldByTwoPartKey(ctx, conn, data.DomainID.ValueString(), data.FieldID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Connect Cases Field (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if output.Type == awstypes.FieldTypeSingleSelect {
+		options, err := findFieldOptionsByTwoPartKey(ctx, conn, data.DomainID.ValueString(), data.FieldID.ValueString())
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("reading Connect Cases Field (%s) options", data.ID.ValueString()), err.Error())
+
+			return
+		}
+
+		response.Diagnostics.Append(data.flattenOptions(ctx, options)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *fieldResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new fieldResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().ConnectCasesClient(ctx)
+
+	if !new.Description.Equal(old.Description) || !new.Name.Equal(old.Name) {
+		input := &connectcases.UpdateFieldInput{}
+		response.Diagnostics.Append(fwflex.Expand(ctx, new, input)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		_, err := conn.UpdateField(ctx, input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating Connect Cases Field (%s)", new.ID.ValueString()), err.Error())
+
+			return
+		}
+	}
+
+	if !new.Options.Equal(old.Options) {
+		var oldOptions, newOptions []awstypes.FieldOption
+		response.Diagnostics.Append(fwflex.Expand(ctx, old.Options, &oldOptions)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+		response.Diagnostics.Append(fwflex.Expand(ctx, new.Options, &newOptions)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		// Options can't be deleted, only deactivated.
+		options := newOptions
+		for _, oldOption := range oldOptions {
+			if !tfslices.Any(newOptions, func(v awstypes.FieldOption) bool {
+				return aws.ToString(v.Value) == aws.ToString(oldOption.Value)
+			}) {
+				oldOption.Active = aws.Bool(false)
+				options = append(options, oldOption)
+			}
+		}
+
+		if err := putFieldOptions(ctx, conn, new.DomainID.ValueString(), new.FieldID.ValueString(), options); err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("putting Connect Cases Field (%s) options", new.ID.ValueString()), err.Error())
+
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *fieldResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data fieldResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().ConnectCasesClient(ctx)
+
+	_, err := conn.DeleteField(ctx, &connectcases.DeleteFieldInput{
+		DomainId: aws.String(data.DomainID.ValueString()),
+		FieldId:  aws.String(data.FieldID.ValueString()),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Connect Cases Field (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func (r *fieldResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	r.SetTagsAll(ctx, request, response)
+}
+
+const (
+	// BatchPutFieldOptions accepts at most 50 options per request.
+	fieldOptionsBatchSize = 50
+	fieldOptionsMaxItems  = 1000
+)
+
+func putFieldOptions(ctx context.Context, conn *connectcases.Client, domainID, fieldID string, options []awstypes.FieldOption) error {
+	for _, chunk := range tfslices.Chunks(options, fieldOptionsBatchSize) {
+		input := &connectcases.BatchPutFieldOptionsInput{
+			DomainId: aws.String(domainID),
+			FieldId:  aws.String(fieldID),
+			Options:  chunk,
+		}
+
+		output, err := conn.BatchPutFieldOptions(ctx, input)
+
+		if err != nil {
+			return err
+		}
+
+		var failures []error
+		for _, v := range output.Errors {
+			failures = append(failures, fmt.Errorf("%s: %s: %s", aws.ToString(v.Value), aws.ToString(v.ErrorCode), aws.ToString(v.Message)))
+		}
+
+		if err := errors.Join(failures...); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func findFieldByTwoPartKey(ctx context.Context, conn *connectcases.Client, domainID, fieldID string) (*awstypes.GetFieldResponse, error) {
+	input := &connectcases.BatchGetFieldInput{
+		DomainId: aws.String(domainID),
+		Fields: []awstypes.FieldIdentifier{{
+			Id: aws.String(fieldID),
+		}},
+	}
+
+	output, err := conn.BatchGetField(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	for _, v := range output.Errors {
+		if aws.ToString(v.ErrorCode) == errCodeResourceNotFound {
+			return nil, &retry.NotFoundError{
+				Message:     aws.ToString(v.Message),
+				LastRequest: input,
+			}
+		}
+
+		return nil, fmt.Errorf("%s: %s", aws.ToString(v.ErrorCode), aws.ToString(v.Message))
+	}
+
+	field, err := tfresource.AssertSingleValueResult(output.Fields)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if field.Deleted {
+		return nil, &retry.NotFoundError{
+			LastRequest: input,
+		}
+	}
+
+	return field, nil
+}
+
+func findFieldOptionsByTwoPartKey(ctx context.Context, conn *connectcases.Client, domainID, fieldID string) ([]awstypes.FieldOption, error) {
+	input := &connectcases.ListFieldOptionsInput{
+		DomainId: aws.String(domainID),
+		FieldId:  aws.String(fieldID),
+	}
+	var output []awstypes.FieldOption
+
+	pages := connectcases.NewListFieldOptionsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.Options...)
+	}
+
+	return output, nil
+}
+
+type fieldResourceModel struct {
+	Description types.String                                     `tfsdk:"description"`
+	DomainID    types.String                                     `tfsdk:"domain_id"`
+	FieldARN    types.String                                     `tfsdk:"arn"`
+	FieldID     types.String                                     `tfsdk:"field_id"`
+	ID          types.String                                     `tfsdk:"id"`
+	Name        types.String                                     `tfsdk:"name"`
+	Namespace   fwtypes.StringEnum[awstypes.FieldNamespace]      `tfsdk:"namespace"`
+	Options     fwtypes.SetNestedObjectValueOf[fieldOptionModel] `tfsdk:"option"`
+	Tags        types.Map                                        `tfsdk:"tags"`
+	TagsAll     types.Map                                        `tfsdk:"tags_all"`
+	Type        fwtypes.StringEnum[awstypes.FieldType]           `tfsdk:"type"`
+}
+
+const (
+	fieldResourceIDPartCount = 2
+)
+
+func (data *fieldResourceModel) InitFromID() error {
+	id := data.ID.ValueString()
+	parts, err := flex.ExpandResourceId(id, fieldResourceIDPartCount, false)
+
+	if err != nil {
+		return err
+	}
+
+	data.DomainID = types.StringValue(parts[0])
+	data.FieldID = types.StringValue(parts[1])
+
+	return nil
+}
+
+func (data *fieldResourceModel) setID() {
+	data.ID = types.StringValue(errs.Must(flex.FlattenResourceId([]string{data.DomainID.ValueString(), data.FieldID.ValueString()}, fieldResourceIDPartCount, false)))
+}
+
+// flattenOptions sets the model's options from the API objects.
+// Deactivated options are kept only if they are already tracked in state.
+func (data *fieldResourceModel) flattenOptions(ctx context.Context, apiObjects []awstypes.FieldOption) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var old []awstypes.FieldOption
+	if !data.Options.IsNull() {
+		diags.Append(fwflex.Expand(ctx, data.Options, &old)...)
+		if diags.HasError() {
+			return diags
+		}
+	}
+
+	options := tfslices.Filter(apiObjects, func(v awstypes.FieldOption) bool {
+		return aws.ToBool(v.Active) || tfslices.Any(old, func(o awstypes.FieldOption) bool {
+			return aws.ToString(o.Value) == aws.ToString(v.Value)
+		})
+	})
+
+	if len(options) == 0 && data.Options.IsNull() {
+		return diags
+	}
+
+	diags.Append(fwflex.Flatten(ctx, options, &data.Options)...)
+
+	return diags
+}
+
+type fieldOptionModel struct {
+	Active types.Bool   `tfsdk:"active"`
+	Name   types.String `tfsdk:"name"`
+	Value  types.String `tfsdk:"value"`
+}
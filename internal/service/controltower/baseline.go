@@ -0,0 +1,396 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package controltower
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/controltower"
+	"github.com/aws/aws-sdk-go-v2/service/controltower/document"
+	"github.com/aws/aws-sdk-go-v2/service/controltower/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_controltower_baseline", name="Baseline")
+// @Tags(identifierAttribute="arn")
+func resourceBaseline() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceBaselineCreate,
+		ReadWithoutTimeout:   resourceBaselineRead,
+		UpdateWithoutTimeout: resourceBaselineUpdate,
+		DeleteWithoutTimeout: resourceBaselineDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"baseline_identifier": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"baseline_version": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			names.AttrParameters: {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrKey: {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						names.AttrValue: {
+							Type:                  schema.TypeString,
+							Required:              true,
+							ValidateFunc:          validation.StringIsJSON,
+							DiffSuppressFunc:      verify.SuppressEquivalentJSONDiffs,
+							DiffSuppressOnRefresh: true,
+							StateFunc: func(v interface{}) string {
+								json, _ := structure.NormalizeJsonString(v)
+								return json
+							},
+						},
+					},
+				},
+			},
+			names.AttrStatus: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			"target_identifier": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+		},
+
+		CustomizeDiff: customdiff.Sequence(
+			verify.SetTagsDiff,
+			baselineResetDiff,
+		),
+	}
+}
+
+func resourceBaselineCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ControlTowerClient(ctx)
+
+	input := &controltower.EnableBaselineInput{
+		BaselineIdentifier: aws.String(d.Get("baseline_identifier").(string)),
+		BaselineVersion:    aws.String(d.Get("baseline_version").(string)),
+		Tags:               getTagsIn(ctx),
+		TargetIdentifier:   aws.String(d.Get("target_identifier").(string)),
+	}
+
+	if v, ok := d.GetOk(names.AttrParameters); ok && len(v.([]interface{})) > 0 {
+		parameters, err := expandEnabledBaselineParameters(v.([]interface{}))
+		if err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+
+		input.Parameters = parameters
+	}
+
+	output, err := conn.EnableBaseline(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "enabling ControlTower Baseline: %s", err)
+	}
+
+	d.SetId(aws.ToString(output.Arn))
+
+	if _, err := waitBaselineOperationSucceeded(ctx, conn, aws.ToString(output.OperationIdentifier), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for ControlTower Baseline (%s) enable: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceBaselineRead(ctx, d, meta)...)
+}
+
+func resourceBaselineRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ControlTowerClient(ctx)
+
+	enabledBaseline, err := findEnabledBaselineByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] ControlTower Baseline (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading ControlTower Baseline (%s): %s", d.Id(), err)
+	}
+
+	d.Set(names.AttrARN, enabledBaseline.Arn)
+	d.Set("baseline_identifier", enabledBaseline.BaselineIdentifier)
+	d.Set("baseline_version", enabledBaseline.BaselineVersion)
+	parameters, err := flattenEnabledBaselineParameterSummaries(enabledBaseline.Parameters)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+	if err := d.Set(names.AttrParameters, parameters); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting parameters: %s", err)
+	}
+	if enabledBaseline.StatusSummary != nil {
+		d.Set(names.AttrStatus, enabledBaseline.StatusSummary.Status)
+	} else {
+		d.Set(names.AttrStatus, nil)
+	}
+	d.Set("target_identifier", enabledBaseline.TargetIdentifier)
+
+	return diags
+}
+
+func resourceBaselineUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ControlTowerClient(ctx)
+
+	if d.HasChanges("baseline_version", names.AttrParameters) {
+		input := &controltower.UpdateEnabledBaselineInput{
+			BaselineVersion:           aws.String(d.Get("baseline_version").(string)),
+			EnabledBaselineIdentifier: aws.String(d.Id()),
+		}
+
+		if v, ok := d.GetOk(names.AttrParameters); ok && len(v.([]interface{})) > 0 {
+			parameters, err := expandEnabledBaselineParameters(v.([]interface{}))
+			if err != nil {
+				return sdkdiag.AppendFromErr(diags, err)
+			}
+
+			input.Parameters = parameters
+		}
+
+		output, err := conn.UpdateEnabledBaseline(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating ControlTower Baseline (%s): %s", d.Id(), err)
+		}
+
+		if _, err := waitBaselineOperationSucceeded(ctx, conn, aws.ToString(output.OperationIdentifier), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for ControlTower Baseline (%s) update: %s", d.Id(), err)
+		}
+	} else if d.HasChange(names.AttrStatus) {
+		output, err := conn.ResetEnabledBaseline(ctx, &controltower.ResetEnabledBaselineInput{
+			EnabledBaselineIdentifier: aws.String(d.Id()),
+		})
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "resetting ControlTower Baseline (%s): %s", d.Id(), err)
+		}
+
+		if _, err := waitBaselineOperationSucceeded(ctx, conn, aws.ToString(output.OperationIdentifier), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for ControlTower Baseline (%s) reset: %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceBaselineRead(ctx, d, meta)...)
+}
+
+func resourceBaselineDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ControlTowerClient(ctx)
+
+	log.Printf("[DEBUG] Deleting ControlTower Baseline: %s", d.Id())
+	output, err := conn.DisableBaseline(ctx, &controltower.DisableBaselineInput{
+		EnabledBaselineIdentifier: aws.String(d.Id()),
+	})
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "disabling ControlTower Baseline (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitBaselineOperationSucceeded(ctx, conn, aws.ToString(output.OperationIdentifier), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for ControlTower Baseline (%s) disable: %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+// baselineResetDiff plans a reset of an enabled baseline whose last operation failed,
+// e.g. when an OU's registration didn't complete for all of its accounts.
+func baselineResetDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() == "" {
+		return nil
+	}
+
+	if status := types.EnablementStatus(d.Get(names.AttrStatus).(string)); status == types.EnablementStatusFailed {
+		return d.SetNew(names.AttrStatus, string(types.EnablementStatusSucceeded))
+	}
+
+	return nil
+}
+
+func findEnabledBaselineByID(ctx context.Context, conn *controltower.Client, id string) (*types.EnabledBaselineDetails, error) {
+	input := &controltower.GetEnabledBaselineInput{
+		EnabledBaselineIdentifier: aws.String(id),
+	}
+
+	output, err := conn.GetEnabledBaseline(ctx, input)
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.EnabledBaselineDetails == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.EnabledBaselineDetails, nil
+}
+
+func findBaselineOperationByID(ctx context.Context, conn *controltower.Client, id string) (*types.BaselineOperation, error) {
+	input := &controltower.GetBaselineOperationInput{
+		OperationIdentifier: aws.String(id),
+	}
+
+	output, err := conn.GetBaselineOperation(ctx, input)
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.BaselineOperation == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.BaselineOperation, nil
+}
+
+func statusBaselineOperation(ctx context.Context, conn *controltower.Client, id string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findBaselineOperationByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.Status), nil
+	}
+}
+
+func waitBaselineOperationSucceeded(ctx context.Context, conn *controltower.Client, id string, timeout time.Duration) (*types.BaselineOperation, error) { //nolint:unparam
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(types.BaselineOperationStatusInProgress),
+		Target:  enum.Slice(types.BaselineOperationStatusSucceeded),
+		Refresh: statusBaselineOperation(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*types.BaselineOperation); ok {
+		if status := output.Status; status == types.BaselineOperationStatusFailed {
+			tfresource.SetLastError(err, errors.New(aws.ToString(output.StatusMessage)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func expandEnabledBaselineParameters(tfList []interface{}) ([]types.EnabledBaselineParameter, error) {
+	var apiObjects []types.EnabledBaselineParameter
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		var value interface{}
+		if err := json.Unmarshal([]byte(tfMap[names.AttrValue].(string)), &value); err != nil {
+			return nil, err
+		}
+
+		apiObjects = append(apiObjects, types.EnabledBaselineParameter{
+			Key:   aws.String(tfMap[names.AttrKey].(string)),
+			Value: document.NewLazyDocument(value),
+		})
+	}
+
+	return apiObjects, nil
+}
+
+func flattenEnabledBaselineParameterSummaries(apiObjects []types.EnabledBaselineParameterSummary) ([]interface{}, error) {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		tfMap := map[string]interface{}{
+			names.AttrKey: aws.ToString(apiObject.Key),
+		}
+
+		if v := apiObject.Value; v != nil {
+			bytes, err := v.MarshalSmithyDocument()
+			if err != nil {
+				return nil, err
+			}
+
+			tfMap[names.AttrValue] = string(bytes)
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList, nil
+}
@@ -16,6 +16,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/controltower/document"
 	"github.com/aws/aws-sdk-go-v2/service/controltower/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
@@ -82,6 +83,11 @@ func resourceLandingZone() *schema.Resource {
 					return json
 				},
 			},
+			"reset_on_drift": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 			names.AttrVersion: {
 				Type:     schema.TypeString,
 				Required: true,
@@ -90,7 +96,10 @@ func resourceLandingZone() *schema.Resource {
 			names.AttrTagsAll: tftags.TagsSchemaComputed(),
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			verify.SetTagsDiff,
+			landingZoneResetOnDriftDiff,
+		),
 	}
 }
 
@@ -165,6 +174,9 @@ func resourceLandingZoneRead(ctx context.Context, d *schema.ResourceData, meta i
 	} else {
 		d.Set("manifest_json", nil)
 	}
+	if _, ok := d.GetOk("reset_on_drift"); !ok {
+		d.Set("reset_on_drift", false)
+	}
 	d.Set(names.AttrVersion, landingZone.Version)
 
 	return diags
@@ -174,7 +186,7 @@ func resourceLandingZoneUpdate(ctx context.Context, d *schema.ResourceData, meta
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).ControlTowerClient(ctx)
 
-	if d.HasChangesExcept(names.AttrTags, names.AttrTagsAll) {
+	if d.HasChanges("manifest_json", names.AttrVersion) {
 		manifest, err := json.SmithyDocumentFromString(d.Get("manifest_json").(string), document.NewLazyDocument)
 		if err != nil {
 			return sdkdiag.AppendFromErr(diags, err)
@@ -195,6 +207,18 @@ func resourceLandingZoneUpdate(ctx context.Context, d *schema.ResourceData, meta
 		if _, err := waitLandingZoneOperationSucceeded(ctx, conn, aws.ToString(output.OperationIdentifier), d.Timeout(schema.TimeoutUpdate)); err != nil {
 			return sdkdiag.AppendErrorf(diags, "waiting for ControlTower Landing Zone (%s) update: %s", d.Id(), err)
 		}
+	} else if d.HasChange("drift_status") && d.Get("reset_on_drift").(bool) {
+		output, err := conn.ResetLandingZone(ctx, &controltower.ResetLandingZoneInput{
+			LandingZoneIdentifier: aws.String(d.Id()),
+		})
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "resetting ControlTower Landing Zone (%s): %s", d.Id(), err)
+		}
+
+		if _, err := waitLandingZoneOperationSucceeded(ctx, conn, aws.ToString(output.OperationIdentifier), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for ControlTower Landing Zone (%s) reset: %s", d.Id(), err)
+		}
 	}
 
 	return append(diags, resourceLandingZoneRead(ctx, d, meta)...)
@@ -220,6 +244,21 @@ func resourceLandingZoneDelete(ctx context.Context, d *schema.ResourceData, meta
 	return diags
 }
 
+// landingZoneResetOnDriftDiff plans a reset of a drifted landing zone when reset_on_drift is enabled.
+func landingZoneResetOnDriftDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() == "" || !d.Get("reset_on_drift").(bool) {
+		return nil
+	}
+
+	if status := types.LandingZoneDriftStatus(d.Get("drift_status.0.status").(string)); status != types.LandingZoneDriftStatusDrifted {
+		return nil
+	}
+
+	return d.SetNew("drift_status", []interface{}{map[string]interface{}{
+		names.AttrStatus: string(types.LandingZoneDriftStatusInSync),
+	}})
+}
+
 func landingZoneIDFromARN(arnString string) (string, error) {
 	arn, err := arn.Parse(arnString)
 	if err != nil {
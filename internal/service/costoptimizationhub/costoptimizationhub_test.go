@@ -0,0 +1,51 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package costoptimizationhub_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/costoptimizationhub"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+func TestAccCostOptimizationHub_serial(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]map[string]func(t *testing.T){
+		"EnrollmentStatus": {
+			acctest.CtBasic:         testAccEnrollmentStatus_basic,
+			acctest.CtDisappears:    testAccEnrollmentStatus_disappears,
+			"includeMemberAccounts": testAccEnrollmentStatus_includeMemberAccounts,
+		},
+		"Preferences": {
+			acctest.CtBasic: testAccPreferences_basic,
+			"update":        testAccPreferences_update,
+		},
+		"RecommendationsDataSource": {
+			acctest.CtBasic: testAccRecommendationsDataSource_basic,
+			"filter":        testAccRecommendationsDataSource_filter,
+		},
+	}
+
+	acctest.RunSerialTests2Levels(t, testCases, 0)
+}
+
+func testAccPreCheck(ctx context.Context, t *testing.T) {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).CostOptimizationHubClient(ctx)
+
+	input := &costoptimizationhub.ListEnrollmentStatusesInput{}
+
+	_, err := conn.ListEnrollmentStatuses(ctx, input)
+
+	if acctest.PreCheckSkipError(err) {
+		t.Skipf("skipping acceptance testing: %s", err)
+	}
+
+	if err != nil {
+		t.Fatalf("unexpected PreCheck error: %s", err)
+	}
+}
@@ -0,0 +1,185 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package costoptimizationhub
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/service/costoptimizationhub"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/costoptimizationhub/types"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_costoptimizationhub_preferences", name="Preferences")
+func newPreferencesResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &preferencesResource{}
+
+	return r, nil
+}
+
+type preferencesResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+}
+
+func (*preferencesResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_costoptimizationhub_preferences"
+}
+
+func (r *preferencesResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	memberAccountDiscountVisibilityType := fwtypes.StringEnumType[awstypes.MemberAccountDiscountVisibility]()
+	savingsEstimationModeType := fwtypes.StringEnumType[awstypes.SavingsEstimationMode]()
+
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrID: framework.IDAttribute(),
+			"member_account_discount_visibility": schema.StringAttribute{
+				CustomType: memberAccountDiscountVisibilityType,
+				Optional:   true,
+				Computed:   true,
+				Default:    memberAccountDiscountVisibilityType.AttributeDefault(awstypes.MemberAccountDiscountVisibilityAll),
+			},
+			"savings_estimation_mode": schema.StringAttribute{
+				CustomType: savingsEstimationModeType,
+				Optional:   true,
+				Computed:   true,
+				Default:    savingsEstimationModeType.AttributeDefault(awstypes.SavingsEstimationModeAfterDiscounts),
+			},
+		},
+	}
+}
+
+func (r *preferencesResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data preferencesResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().CostOptimizationHubClient(ctx)
+
+	input := &costoptimizationhub.UpdatePreferencesInput{}
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := conn.UpdatePreferences(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError("creating Cost Optimization Hub Preferences", err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.ID = types.StringValue(r.Meta().AccountID)
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *preferencesResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data preferencesResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().CostOptimizationHubClient(ctx)
+
+	output, err := findPreferences(ctx, conn)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Cost Optimization Hub Preferences (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *preferencesResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var new preferencesResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().CostOptimizationHubClient(ctx)
+
+	input := &costoptimizationhub.UpdatePreferencesInput{}
+	response.Diagnostics.Append(fwflex.Expand(ctx, new, input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := conn.UpdatePreferences(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("updating Cost Optimization Hub Preferences (%s)", new.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+// Delete restores the service defaults.
+func (r *preferencesResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data preferencesResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().CostOptimizationHubClient(ctx)
+
+	input := &costoptimizationhub.UpdatePreferencesInput{
+		MemberAccountDiscountVisibility: awstypes.MemberAccountDiscountVisibilityAll,
+		SavingsEstimationMode:           awstypes.SavingsEstimationModeAfterDiscounts,
+	}
+
+	_, err := conn.UpdatePreferences(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Cost Optimization Hub Preferences (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func findPreferences(ctx context.Context, conn *costoptimizationhub.Client) (*costoptimizationhub.GetPreferencesOutput, error) {
+	input := &costoptimizationhub.GetPreferencesInput{}
+
+	output, err := conn.GetPreferences(ctx, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+type preferencesResourceModel struct {
+	ID                              types.String                                                 `tfsdk:"id"`
+	MemberAccountDiscountVisibility fwtypes.StringEnum[awstypes.MemberAccountDiscountVisibility] `tfsdk:"member_account_discount_visibility"`
+	SavingsEstimationMode           fwtypes.StringEnum[awstypes.SavingsEstimationMode]           `tfsdk:"savings_estimation_mode"`
+}
@@ -0,0 +1,145 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package costoptimizationhub_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfcostoptimizationhub "github.com/hashicorp/terraform-provider-aws/internal/service/costoptimizationhub"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func testAccPreferences_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_costoptimizationhub_preferences.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CostOptimizationHubServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPreferencesDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPreferencesConfig_basic,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckPreferencesExists(ctx, resourceName),
+					acctest.CheckResourceAttrAccountID(resourceName, names.AttrID),
+					resource.TestCheckResourceAttr(resourceName, "member_account_discount_visibility", "All"),
+					resource.TestCheckResourceAttr(resourceName, "savings_estimation_mode", "AfterDiscounts"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccPreferences_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_costoptimizationhub_preferences.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CostOptimizationHubServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPreferencesDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPreferencesConfig_full("BeforeDiscounts", "None"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckPreferencesExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "member_account_discount_visibility", "None"),
+					resource.TestCheckResourceAttr(resourceName, "savings_estimation_mode", "BeforeDiscounts"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccPreferencesConfig_full("AfterDiscounts", "All"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckPreferencesExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "member_account_discount_visibility", "All"),
+					resource.TestCheckResourceAttr(resourceName, "savings_estimation_mode", "AfterDiscounts"),
+				),
+			},
+		},
+	})
+}
+
+// Preferences can't be removed, only reset to the service defaults.
+func testAccCheckPreferencesDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).CostOptimizationHubClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_costoptimizationhub_preferences" {
+				continue
+			}
+
+			output, err := tfcostoptimizationhub.FindPreferences(ctx, conn)
+
+			if err != nil {
+				return err
+			}
+
+			if v := string(output.MemberAccountDiscountVisibility); v != "All" {
+				return fmt.Errorf("Cost Optimization Hub Preferences %s member_account_discount_visibility not reset: %s", rs.Primary.ID, v)
+			}
+
+			if v := string(output.SavingsEstimationMode); v != "AfterDiscounts" {
+				return fmt.Errorf("Cost Optimization Hub Preferences %s savings_estimation_mode not reset: %s", rs.Primary.ID, v)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckPreferencesExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		_, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).CostOptimizationHubClient(ctx)
+
+		_, err := tfcostoptimizationhub.FindPreferences(ctx, conn)
+
+		return err
+	}
+}
+
+const testAccPreferencesConfig_basic = `
+resource "aws_costoptimizationhub_enrollment_status" "test" {}
+
+resource "aws_costoptimizationhub_preferences" "test" {
+  depends_on = [aws_costoptimizationhub_enrollment_status.test]
+}
+`
+
+func testAccPreferencesConfig_full(savingsEstimationMode, memberAccountDiscountVisibility string) string {
+	return fmt.Sprintf(`
+resource "aws_costoptimizationhub_enrollment_status" "test" {}
+
+resource "aws_costoptimizationhub_preferences" "test" {
+  savings_estimation_mode            = %[1]q
+  member_account_discount_visibility = %[2]q
+
+  depends_on = [aws_costoptimizationhub_enrollment_status.test]
+}
+`, savingsEstimationMode, memberAccountDiscountVisibility)
+}
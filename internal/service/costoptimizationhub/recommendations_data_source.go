@@ -0,0 +1,173 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package costoptimizationhub
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/costoptimizationhub"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/costoptimizationhub/types"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource("aws_costoptimizationhub_recommendations", name="Recommendations")
+func newRecommendationsDataSource(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &recommendationsDataSource{}, nil
+}
+
+type recommendationsDataSource struct {
+	framework.DataSourceWithConfigure
+}
+
+func (*recommendationsDataSource) Metadata(_ context.Context, request datasource.MetadataRequest, response *datasource.MetadataResponse) {
+	response.TypeName = "aws_costoptimizationhub_recommendations"
+}
+
+func (d *recommendationsDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"include_all_recommendations": schema.BoolAttribute{
+				Optional: true,
+			},
+			"recommendations": schema.ListAttribute{
+				CustomType:  fwtypes.NewListNestedObjectTypeOf[recommendationModel](ctx),
+				ElementType: fwtypes.NewObjectTypeOf[recommendationModel](ctx),
+				Computed:    true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			names.AttrFilter: schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[recommendationsFilterModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"account_ids": schema.SetAttribute{
+							CustomType:  fwtypes.SetOfStringType,
+							ElementType: types.StringType,
+							Optional:    true,
+						},
+						"action_types": schema.SetAttribute{
+							CustomType:  fwtypes.SetOfStringType,
+							ElementType: types.StringType,
+							Optional:    true,
+							Validators: []validator.Set{
+								setvalidator.ValueStringsAre(enum.FrameworkValidate[awstypes.ActionType]()),
+							},
+						},
+						"regions": schema.SetAttribute{
+							CustomType:  fwtypes.SetOfStringType,
+							ElementType: types.StringType,
+							Optional:    true,
+						},
+						"resource_types": schema.SetAttribute{
+							CustomType:  fwtypes.SetOfStringType,
+							ElementType: types.StringType,
+							Optional:    true,
+							Validators: []validator.Set{
+								setvalidator.ValueStringsAre(enum.FrameworkValidate[awstypes.ResourceType]()),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *recommendationsDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data recommendationsDataSourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := d.Meta().CostOptimizationHubClient(ctx)
+
+	input := &costoptimizationhub.ListRecommendationsInput{}
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	output, err := findRecommendations(ctx, conn, input)
+
+	if err != nil {
+		response.Diagnostics.AddError("reading Cost Optimization Hub Recommendations", err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, &costoptimizationhub.ListRecommendationsOutput{Items: output}, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func findRecommendations(ctx context.Context, conn *costoptimizationhub.Client, input *costoptimizationhub.ListRecommendationsInput) ([]awstypes.Recommendation, error) {
+	var output []awstypes.Recommendation
+
+	pages := costoptimizationhub.NewListRecommendationsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.Items...)
+	}
+
+	return output, nil
+}
+
+type recommendationsDataSourceModel struct {
+	Filter                    fwtypes.ListNestedObjectValueOf[recommendationsFilterModel] `tfsdk:"filter"`
+	IncludeAllRecommendations types.Bool                                                  `tfsdk:"include_all_recommendations"`
+	Items                     fwtypes.ListNestedObjectValueOf[recommendationModel]        `tfsdk:"recommendations"`
+}
+
+type recommendationsFilterModel struct {
+	AccountIDs    fwtypes.SetValueOf[types.String] `tfsdk:"account_ids"`
+	ActionTypes   fwtypes.SetValueOf[types.String] `tfsdk:"action_types"`
+	Regions       fwtypes.SetValueOf[types.String] `tfsdk:"regions"`
+	ResourceTypes fwtypes.SetValueOf[types.String] `tfsdk:"resource_types"`
+}
+
+type recommendationModel struct {
+	AccountID                          types.String      `tfsdk:"account_id"`
+	ActionType                         types.String      `tfsdk:"action_type"`
+	CurrencyCode                       types.String      `tfsdk:"currency_code"`
+	CurrentResourceSummary             types.String      `tfsdk:"current_resource_summary"`
+	CurrentResourceType                types.String      `tfsdk:"current_resource_type"`
+	EstimatedMonthlyCost               types.Float64     `tfsdk:"estimated_monthly_cost"`
+	EstimatedMonthlySavings            types.Float64     `tfsdk:"estimated_monthly_savings"`
+	EstimatedSavingsPercentage         types.Float64     `tfsdk:"estimated_savings_percentage"`
+	ImplementationEffort               types.String      `tfsdk:"implementation_effort"`
+	LastRefreshTimestamp               timetypes.RFC3339 `tfsdk:"last_refresh_timestamp"`
+	RecommendationID                   types.String      `tfsdk:"recommendation_id"`
+	RecommendationLookbackPeriodInDays types.Int64       `tfsdk:"recommendation_lookback_period_in_days"`
+	RecommendedResourceSummary         types.String      `tfsdk:"recommended_resource_summary"`
+	RecommendedResourceType            types.String      `tfsdk:"recommended_resource_type"`
+	Region                             types.String      `tfsdk:"region"`
+	ResourceARN                        types.String      `tfsdk:"resource_arn"`
+	ResourceID                         types.String      `tfsdk:"resource_id"`
+	RestartNeeded                      types.Bool        `tfsdk:"restart_needed"`
+	RollbackPossible                   types.Bool        `tfsdk:"rollback_possible"`
+	Source                             types.String      `tfsdk:"source"`
+}
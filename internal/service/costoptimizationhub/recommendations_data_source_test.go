@@ -0,0 +1,80 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package costoptimizationhub_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func testAccRecommendationsDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_costoptimizationhub_recommendations.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CostOptimizationHubServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRecommendationsDataSourceConfig_basic,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "recommendations.#"),
+				),
+			},
+		},
+	})
+}
+
+func testAccRecommendationsDataSource_filter(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_costoptimizationhub_recommendations.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CostOptimizationHubServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRecommendationsDataSourceConfig_filter,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "recommendations.#"),
+					resource.TestCheckResourceAttr(dataSourceName, "filter.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(dataSourceName, "filter.0.action_types.#", acctest.Ct2),
+					resource.TestCheckResourceAttr(dataSourceName, "filter.0.resource_types.#", acctest.Ct1),
+				),
+			},
+		},
+	})
+}
+
+const testAccRecommendationsDataSourceConfig_basic = `
+resource "aws_costoptimizationhub_enrollment_status" "test" {}
+
+data "aws_costoptimizationhub_recommendations" "test" {
+  depends_on = [aws_costoptimizationhub_enrollment_status.test]
+}
+`
+
+const testAccRecommendationsDataSourceConfig_filter = `
+data "aws_caller_identity" "current" {}
+
+data "aws_region" "current" {}
+
+resource "aws_costoptimizationhub_enrollment_status" "test" {}
+
+data "aws_costoptimizationhub_recommendations" "test" {
+  filter {
+    account_ids    = [data.aws_caller_identity.current.account_id]
+    action_types   = ["Rightsize", "Stop"]
+    regions        = [data.aws_region.current.name]
+    resource_types = ["Ec2Instance"]
+  }
+
+  depends_on = [aws_costoptimizationhub_enrollment_status.test]
+}
+`
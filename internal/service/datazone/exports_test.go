@@ -6,6 +6,21 @@ package datazone
 // Exports for use in tests only.
 var (
 	ResourceDomain                            = newResourceDomain
+	ResourceEnvironment                       = newResourceEnvironment
 	ResourceEnvironmentBlueprintConfiguration = newResourceEnvironmentBlueprintConfiguration
+	ResourceEnvironmentProfile                = newResourceEnvironmentProfile
+	ResourceFormType                          = newResourceFormType
+	ResourceGlossary                          = newResourceGlossary
+	ResourceGlossaryTerm                      = newResourceGlossaryTerm
+	ResourceProject                           = newResourceProject
+	ResourceUserProfile                       = newResourceUserProfile
 	IsResourceMissing                         = isResourceMissing
+
+	FindEnvironmentByID        = findEnvironmentByID
+	FindEnvironmentProfileByID = findEnvironmentProfileByID
+	FindFormTypeByID           = findFormTypeByID
+	FindGlossaryByID           = findGlossaryByID
+	FindGlossaryTermByID       = findGlossaryTermByID
+	FindProjectByID            = findProjectByID
+	FindUserProfileByID        = findUserProfileByID
 )
@@ -0,0 +1,367 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package datazone
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/datazone"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/datazone/types"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Form Type")
+func newResourceFormType(_ context.Context) (resource.ResourceWithConfigure, error) {
+	return &resourceFormType{}, nil
+}
+
+const (
+	ResNameFormType = "Form Type"
+)
+
+type resourceFormType struct {
+	framework.ResourceWithConfigure
+	framework.WithNoOpUpdate[formTypeResourceModel]
+}
+
+func (r *resourceFormType) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "aws_datazone_form_type"
+}
+
+func (r *resourceFormType) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrCreatedAt: schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"created_by": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrDescription: schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(2048),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"domain_identifier": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			"imports": schema.ListAttribute{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[importModel](ctx),
+				Computed:   true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 128),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"origin_domain_id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"origin_project_id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"owning_project_identifier": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"revision": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrStatus: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.FormTypeStatus](),
+				Optional:   true,
+				Computed:   true,
+				Default:    stringdefault.StaticString(string(awstypes.FormTypeStatusEnabled)),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"model": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[modelModel](ctx),
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtLeast(1),
+					listvalidator.SizeAtMost(1),
+				},
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"smithy": schema.StringAttribute{
+							Required: true,
+							Validators: []validator.String{
+								stringvalidator.LengthBetween(1, 100000),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *resourceFormType) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	conn := r.Meta().DataZoneClient(ctx)
+
+	var plan formTypeResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	in := &datazone.CreateFormTypeInput{
+		DomainIdentifier:        aws.String(plan.DomainIdentifier.ValueString()),
+		Name:                    aws.String(plan.Name.ValueString()),
+		OwningProjectIdentifier: aws.String(plan.OwningProjectIdentifier.ValueString()),
+		Status:                  plan.Status.ValueEnum(),
+	}
+
+	if !plan.Description.IsNull() {
+		in.Description = aws.String(plan.Description.ValueString())
+	}
+
+	model, d := plan.Model.ToPtr(ctx)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	in.Model = &awstypes.ModelMemberSmithy{
+		Value: model.Smithy.ValueString(),
+	}
+
+	out, err := conn.CreateFormType(ctx, in)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.DataZone, create.ErrActionCreating, ResNameFormType, plan.Name.String(), err),
+			err.Error(),
+		)
+		return
+	}
+	if out == nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.DataZone, create.ErrActionCreating, ResNameFormType, plan.Name.String(), nil),
+			errors.New("empty output").Error(),
+		)
+		return
+	}
+
+	plan.ID = flex.StringToFramework(ctx, out.Name)
+
+	formType, err := findFormTypeByID(ctx, conn, plan.DomainIdentifier.ValueString(), plan.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.DataZone, create.ErrActionCreating, ResNameFormType, plan.ID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(flex.Flatten(ctx, formType.Imports, &plan.Imports)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.CreatedAt = flex.TimeToFramework(ctx, formType.CreatedAt)
+	plan.CreatedBy = flex.StringToFramework(ctx, formType.CreatedBy)
+	plan.OriginDomainID = flex.StringToFramework(ctx, formType.OriginDomainId)
+	plan.OriginProjectID = flex.StringToFramework(ctx, formType.OriginProjectId)
+	plan.Revision = flex.StringToFramework(ctx, formType.Revision)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *resourceFormType) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	conn := r.Meta().DataZoneClient(ctx)
+
+	var state formTypeResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	out, err := findFormTypeByID(ctx, conn, state.DomainIdentifier.ValueString(), state.ID.ValueString())
+	if tfresource.NotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.DataZone, create.ErrActionSetting, ResNameFormType, state.ID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(flex.Flatten(ctx, out.Imports, &state.Imports)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state.CreatedAt = flex.TimeToFramework(ctx, out.CreatedAt)
+	state.CreatedBy = flex.StringToFramework(ctx, out.CreatedBy)
+	state.Description = flex.StringToFramework(ctx, out.Description)
+	state.DomainIdentifier = flex.StringToFramework(ctx, out.DomainId)
+	state.Name = flex.StringToFramework(ctx, out.Name)
+	state.OriginDomainID = flex.StringToFramework(ctx, out.OriginDomainId)
+	state.OriginProjectID = flex.StringToFramework(ctx, out.OriginProjectId)
+	state.OwningProjectIdentifier = flex.StringToFramework(ctx, out.OwningProjectId)
+	state.Revision = flex.StringToFramework(ctx, out.Revision)
+	state.Status = fwtypes.StringEnumValue(out.Status)
+
+	if v, ok := out.Model.(*awstypes.ModelMemberSmithy); ok {
+		state.Model = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &modelModel{
+			Smithy: types.StringValue(v.Value),
+		})
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *resourceFormType) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	conn := r.Meta().DataZoneClient(ctx)
+
+	var state formTypeResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	in := &datazone.DeleteFormTypeInput{
+		DomainIdentifier:   aws.String(state.DomainIdentifier.ValueString()),
+		FormTypeIdentifier: aws.String(state.ID.ValueString()),
+	}
+
+	_, err := conn.DeleteFormType(ctx, in)
+	if err != nil {
+		if isResourceMissing(err) {
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.DataZone, create.ErrActionDeleting, ResNameFormType, state.ID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+}
+
+func (r *resourceFormType) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts := strings.Split(req.ID, "/")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		resp.Diagnostics.AddError("Resource Import Invalid ID", fmt.Sprintf("Wrong format for import ID (%s), use: 'domain-identifier/form-type-name'", req.ID))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("domain_identifier"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(names.AttrID), parts[1])...)
+}
+
+func findFormTypeByID(ctx context.Context, conn *datazone.Client, domainID, name string) (*datazone.GetFormTypeOutput, error) {
+	in := &datazone.GetFormTypeInput{
+		DomainIdentifier:   aws.String(domainID),
+		FormTypeIdentifier: aws.String(name),
+	}
+
+	out, err := conn.GetFormType(ctx, in)
+	if err != nil {
+		if isResourceMissing(err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: in,
+			}
+		}
+
+		return nil, err
+	}
+
+	if out == nil {
+		return nil, tfresource.NewEmptyResultError(in)
+	}
+
+	return out, nil
+}
+
+type formTypeResourceModel struct {
+	CreatedAt               timetypes.RFC3339                            `tfsdk:"created_at"`
+	CreatedBy               types.String                                 `tfsdk:"created_by"`
+	Description             types.String                                 `tfsdk:"description"`
+	DomainIdentifier        types.String                                 `tfsdk:"domain_identifier"`
+	ID                      types.String                                 `tfsdk:"id"`
+	Imports                 fwtypes.ListNestedObjectValueOf[importModel] `tfsdk:"imports"`
+	Model                   fwtypes.ListNestedObjectValueOf[modelModel]  `tfsdk:"model"`
+	Name                    types.String                                 `tfsdk:"name"`
+	OriginDomainID          types.String                                 `tfsdk:"origin_domain_id"`
+	OriginProjectID         types.String                                 `tfsdk:"origin_project_id"`
+	OwningProjectIdentifier types.String                                 `tfsdk:"owning_project_identifier"`
+	Revision                types.String                                 `tfsdk:"revision"`
+	Status                  fwtypes.StringEnum[awstypes.FormTypeStatus]  `tfsdk:"status"`
+}
+
+type importModel struct {
+	Name     types.String `tfsdk:"name"`
+	Revision types.String `tfsdk:"revision"`
+}
+
+type modelModel struct {
+	Smithy types.String `tfsdk:"smithy"`
+}
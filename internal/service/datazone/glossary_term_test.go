@@ -0,0 +1,225 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package datazone_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/datazone"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	tfdatazone "github.com/hashicorp/terraform-provider-aws/internal/service/datazone"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccDataZoneGlossaryTerm_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	var glossaryterm datazone.GetGlossaryTermOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_datazone_glossary_term.test"
+	glossaryName := "aws_datazone_glossary.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.DataZoneServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckGlossaryTermDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGlossaryTermConfig_basic(rName, "short"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGlossaryTermExists(ctx, resourceName, &glossaryterm),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrCreatedAt),
+					resource.TestCheckResourceAttrSet(resourceName, "created_by"),
+					resource.TestCheckResourceAttrPair(resourceName, "glossary_identifier", glossaryName, names.AttrID),
+					resource.TestCheckResourceAttr(resourceName, "long_description", "long description"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+					resource.TestCheckResourceAttr(resourceName, "short_description", "short"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, "ENABLED"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccDomainChildImportStateIdFunc(resourceName),
+			},
+			{
+				Config: testAccGlossaryTermConfig_basic(rName, "updated"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGlossaryTermExists(ctx, resourceName, &glossaryterm),
+					resource.TestCheckResourceAttr(resourceName, "short_description", "updated"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDataZoneGlossaryTerm_termRelations(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	var glossaryterm datazone.GetGlossaryTermOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_datazone_glossary_term.test2"
+	relatedName := "aws_datazone_glossary_term.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.DataZoneServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckGlossaryTermDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGlossaryTermConfig_termRelations(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGlossaryTermExists(ctx, resourceName, &glossaryterm),
+					resource.TestCheckResourceAttr(resourceName, "term_relations.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "term_relations.0.is_a.#", acctest.Ct1),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "term_relations.0.is_a.*", relatedName, names.AttrID),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccDomainChildImportStateIdFunc(resourceName),
+			},
+		},
+	})
+}
+
+func TestAccDataZoneGlossaryTerm_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	var glossaryterm datazone.GetGlossaryTermOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_datazone_glossary_term.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.DataZoneServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckGlossaryTermDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGlossaryTermConfig_basic(rName, "short"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGlossaryTermExists(ctx, resourceName, &glossaryterm),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfdatazone.ResourceGlossaryTerm, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckGlossaryTermDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).DataZoneClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_datazone_glossary_term" {
+				continue
+			}
+
+			_, err := tfdatazone.FindGlossaryTermByID(ctx, conn, rs.Primary.Attributes["domain_identifier"], rs.Primary.ID)
+			if tfresource.NotFound(err) {
+				continue
+			}
+			if err != nil {
+				return create.Error(names.DataZone, create.ErrActionCheckingDestroyed, tfdatazone.ResNameGlossaryTerm, rs.Primary.ID, err)
+			}
+
+			return create.Error(names.DataZone, create.ErrActionCheckingDestroyed, tfdatazone.ResNameGlossaryTerm, rs.Primary.ID, errors.New("not destroyed"))
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckGlossaryTermExists(ctx context.Context, name string, glossaryterm *datazone.GetGlossaryTermOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return create.Error(names.DataZone, create.ErrActionCheckingExistence, tfdatazone.ResNameGlossaryTerm, name, errors.New("not found"))
+		}
+
+		if rs.Primary.ID == "" {
+			return create.Error(names.DataZone, create.ErrActionCheckingExistence, tfdatazone.ResNameGlossaryTerm, name, errors.New("not set"))
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).DataZoneClient(ctx)
+		resp, err := tfdatazone.FindGlossaryTermByID(ctx, conn, rs.Primary.Attributes["domain_identifier"], rs.Primary.ID)
+		if err != nil {
+			return create.Error(names.DataZone, create.ErrActionCheckingExistence, tfdatazone.ResNameGlossaryTerm, rs.Primary.ID, err)
+		}
+
+		*glossaryterm = *resp
+
+		return nil
+	}
+}
+
+func testAccGlossaryTermConfig_base(rName string) string {
+	return acctest.ConfigCompose(
+		testAccProjectConfig_base(rName),
+		fmt.Sprintf(`
+resource "aws_datazone_glossary" "test" {
+  domain_identifier         = aws_datazone_domain.test.id
+  owning_project_identifier = aws_datazone_project.test.id
+  name                      = %[1]q
+}
+`, rName),
+	)
+}
+
+func testAccGlossaryTermConfig_basic(rName, shortDescription string) string {
+	return acctest.ConfigCompose(
+		testAccGlossaryTermConfig_base(rName),
+		fmt.Sprintf(`
+resource "aws_datazone_glossary_term" "test" {
+  domain_identifier   = aws_datazone_domain.test.id
+  glossary_identifier = aws_datazone_glossary.test.id
+  name                = %[1]q
+  long_description    = "long description"
+  short_description   = %[2]q
+}
+`, rName, shortDescription),
+	)
+}
+
+func testAccGlossaryTermConfig_termRelations(rName string) string {
+	return acctest.ConfigCompose(
+		testAccGlossaryTermConfig_basic(rName, "short"),
+		fmt.Sprintf(`
+resource "aws_datazone_glossary_term" "test2" {
+  domain_identifier   = aws_datazone_domain.test.id
+  glossary_identifier = aws_datazone_glossary.test.id
+  name                = "%[1]s-2"
+
+  term_relations {
+    is_a = [aws_datazone_glossary_term.test.id]
+  }
+}
+`, rName),
+	)
+}
@@ -0,0 +1,358 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package datazone
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/datazone"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/datazone/types"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	sdkid "github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="User Profile")
+func newResourceUserProfile(_ context.Context) (resource.ResourceWithConfigure, error) {
+	return &resourceUserProfile{}, nil
+}
+
+const (
+	ResNameUserProfile = "User Profile"
+)
+
+type resourceUserProfile struct {
+	framework.ResourceWithConfigure
+}
+
+func (r *resourceUserProfile) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "aws_datazone_user_profile"
+}
+
+func (r *resourceUserProfile) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"details": schema.ListAttribute{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[userProfileDetailsModel](ctx),
+				Computed:   true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"domain_identifier": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			names.AttrStatus: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.UserProfileStatus](),
+				Optional:   true,
+				Computed:   true,
+			},
+			names.AttrType: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.UserProfileType](),
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"user_identifier": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"user_type": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.UserType](),
+				Optional:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+func (r *resourceUserProfile) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	conn := r.Meta().DataZoneClient(ctx)
+
+	var plan userProfileResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	in := &datazone.CreateUserProfileInput{
+		ClientToken:      aws.String(sdkid.UniqueId()),
+		DomainIdentifier: aws.String(plan.DomainIdentifier.ValueString()),
+		UserIdentifier:   aws.String(plan.UserIdentifier.ValueString()),
+		UserType:         plan.UserType.ValueEnum(),
+	}
+
+	out, err := conn.CreateUserProfile(ctx, in)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.DataZone, create.ErrActionCreating, ResNameUserProfile, plan.UserIdentifier.String(), err),
+			err.Error(),
+		)
+		return
+	}
+	if out == nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.DataZone, create.ErrActionCreating, ResNameUserProfile, plan.UserIdentifier.String(), nil),
+			errors.New("empty output").Error(),
+		)
+		return
+	}
+
+	plan.ID = flex.StringToFramework(ctx, out.Id)
+	plan.Type = fwtypes.StringEnumValue(out.Type)
+
+	status := out.Status
+	if !plan.Status.IsUnknown() && plan.Status.ValueEnum() != status {
+		updateOut, err := conn.UpdateUserProfile(ctx, &datazone.UpdateUserProfileInput{
+			DomainIdentifier: aws.String(plan.DomainIdentifier.ValueString()),
+			Status:           plan.Status.ValueEnum(),
+			Type:             out.Type,
+			UserIdentifier:   aws.String(plan.UserIdentifier.ValueString()),
+		})
+		if err != nil {
+			resp.State.SetAttribute(ctx, path.Root(names.AttrID), plan.ID) // Set 'id' so as to taint the resource.
+			resp.Diagnostics.AddError(
+				create.ProblemStandardMessage(names.DataZone, create.ErrActionCreating, ResNameUserProfile, plan.ID.String(), err),
+				err.Error(),
+			)
+			return
+		}
+
+		status = updateOut.Status
+	}
+
+	plan.Status = fwtypes.StringEnumValue(status)
+
+	details, d := flattenUserProfileDetails(ctx, out.Details)
+	resp.Diagnostics.Append(d...)
+	plan.Details = details
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *resourceUserProfile) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	conn := r.Meta().DataZoneClient(ctx)
+
+	var state userProfileResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	out, err := findUserProfileByID(ctx, conn, state.DomainIdentifier.ValueString(), state.UserIdentifier.ValueString(), state.Type.ValueEnum())
+	if tfresource.NotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.DataZone, create.ErrActionSetting, ResNameUserProfile, state.ID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	state.DomainIdentifier = flex.StringToFramework(ctx, out.DomainId)
+	state.ID = flex.StringToFramework(ctx, out.Id)
+	state.Status = fwtypes.StringEnumValue(out.Status)
+	state.Type = fwtypes.StringEnumValue(out.Type)
+
+	details, d := flattenUserProfileDetails(ctx, out.Details)
+	resp.Diagnostics.Append(d...)
+	state.Details = details
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *resourceUserProfile) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	conn := r.Meta().DataZoneClient(ctx)
+
+	var plan, state userProfileResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.Status.IsUnknown() && !plan.Status.Equal(state.Status) {
+		in := &datazone.UpdateUserProfileInput{
+			DomainIdentifier: aws.String(plan.DomainIdentifier.ValueString()),
+			Status:           plan.Status.ValueEnum(),
+			Type:             state.Type.ValueEnum(),
+			UserIdentifier:   aws.String(plan.UserIdentifier.ValueString()),
+		}
+
+		out, err := conn.UpdateUserProfile(ctx, in)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				create.ProblemStandardMessage(names.DataZone, create.ErrActionUpdating, ResNameUserProfile, plan.ID.String(), err),
+				err.Error(),
+			)
+			return
+		}
+		if out == nil {
+			resp.Diagnostics.AddError(
+				create.ProblemStandardMessage(names.DataZone, create.ErrActionUpdating, ResNameUserProfile, plan.ID.String(), nil),
+				errors.New("empty output").Error(),
+			)
+			return
+		}
+
+		plan.Status = fwtypes.StringEnumValue(out.Status)
+	} else {
+		plan.Status = state.Status
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *resourceUserProfile) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	conn := r.Meta().DataZoneClient(ctx)
+
+	var state userProfileResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// There is no API to delete a user profile, so the profile is deactivated instead.
+	if state.Status.ValueEnum() == awstypes.UserProfileStatusDeactivated {
+		return
+	}
+
+	in := &datazone.UpdateUserProfileInput{
+		DomainIdentifier: aws.String(state.DomainIdentifier.ValueString()),
+		Status:           awstypes.UserProfileStatusDeactivated,
+		Type:             state.Type.ValueEnum(),
+		UserIdentifier:   aws.String(state.UserIdentifier.ValueString()),
+	}
+
+	_, err := conn.UpdateUserProfile(ctx, in)
+	if err != nil {
+		if isResourceMissing(err) {
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.DataZone, create.ErrActionDeleting, ResNameUserProfile, state.ID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+}
+
+func (r *resourceUserProfile) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts := strings.Split(req.ID, "/")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		resp.Diagnostics.AddError("Resource Import Invalid ID", fmt.Sprintf("Wrong format for import ID (%s), use: 'domain-identifier/user-identifier'", req.ID))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("domain_identifier"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("user_identifier"), parts[1])...)
+}
+
+func findUserProfileByID(ctx context.Context, conn *datazone.Client, domainID, userID string, profileType awstypes.UserProfileType) (*datazone.GetUserProfileOutput, error) {
+	in := &datazone.GetUserProfileInput{
+		DomainIdentifier: aws.String(domainID),
+		Type:             profileType,
+		UserIdentifier:   aws.String(userID),
+	}
+
+	out, err := conn.GetUserProfile(ctx, in)
+	if err != nil {
+		if isResourceMissing(err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: in,
+			}
+		}
+
+		return nil, err
+	}
+
+	if out == nil {
+		return nil, tfresource.NewEmptyResultError(in)
+	}
+
+	return out, nil
+}
+
+func flattenUserProfileDetails(ctx context.Context, apiObject awstypes.UserProfileDetails) (fwtypes.ListNestedObjectValueOf[userProfileDetailsModel], diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if apiObject == nil {
+		return fwtypes.NewListNestedObjectValueOfNull[userProfileDetailsModel](ctx), diags
+	}
+
+	tfObject := &userProfileDetailsModel{
+		IAM: fwtypes.NewListNestedObjectValueOfNull[iamUserProfileDetailsModel](ctx),
+		SSO: fwtypes.NewListNestedObjectValueOfNull[ssoUserProfileDetailsModel](ctx),
+	}
+
+	switch v := apiObject.(type) {
+	case *awstypes.UserProfileDetailsMemberIam:
+		var model iamUserProfileDetailsModel
+		diags.Append(flex.Flatten(ctx, v.Value, &model)...)
+		tfObject.IAM = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &model)
+
+	case *awstypes.UserProfileDetailsMemberSso:
+		var model ssoUserProfileDetailsModel
+		diags.Append(flex.Flatten(ctx, v.Value, &model)...)
+		tfObject.SSO = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &model)
+	}
+
+	return fwtypes.NewListNestedObjectValueOfPtrMust(ctx, tfObject), diags
+}
+
+type userProfileResourceModel struct {
+	Details          fwtypes.ListNestedObjectValueOf[userProfileDetailsModel] `tfsdk:"details"`
+	DomainIdentifier types.String                                             `tfsdk:"domain_identifier"`
+	ID               types.String                                             `tfsdk:"id"`
+	Status           fwtypes.StringEnum[awstypes.UserProfileStatus]           `tfsdk:"status"`
+	Type             fwtypes.StringEnum[awstypes.UserProfileType]             `tfsdk:"type"`
+	UserIdentifier   types.String                                             `tfsdk:"user_identifier"`
+	UserType         fwtypes.StringEnum[awstypes.UserType]                    `tfsdk:"user_type"`
+}
+
+type userProfileDetailsModel struct {
+	IAM fwtypes.ListNestedObjectValueOf[iamUserProfileDetailsModel] `tfsdk:"iam"`
+	SSO fwtypes.ListNestedObjectValueOf[ssoUserProfileDetailsModel] `tfsdk:"sso"`
+}
+
+type iamUserProfileDetailsModel struct {
+	ARN types.String `tfsdk:"arn"`
+}
+
+type ssoUserProfileDetailsModel struct {
+	FirstName types.String `tfsdk:"first_name"`
+	LastName  types.String `tfsdk:"last_name"`
+	Username  types.String `tfsdk:"username"`
+}
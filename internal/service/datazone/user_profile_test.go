@@ -0,0 +1,122 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package datazone_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/datazone"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/datazone/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	tfdatazone "github.com/hashicorp/terraform-provider-aws/internal/service/datazone"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccDataZoneUserProfile_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	var userprofile datazone.GetUserProfileOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_datazone_user_profile.test"
+	userName := "aws_iam_user.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.DataZoneServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccUserProfileConfig_basic(rName, "ACTIVATED"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckUserProfileExists(ctx, resourceName, &userprofile),
+					resource.TestCheckResourceAttr(resourceName, "details.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "details.0.iam.#", acctest.Ct1),
+					resource.TestCheckResourceAttrPair(resourceName, "details.0.iam.0.arn", userName, names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, "ACTIVATED"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrType, "IAM"),
+					resource.TestCheckResourceAttrPair(resourceName, "user_identifier", userName, names.AttrARN),
+				),
+			},
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+				ImportStateIdFunc:                    testAccUserProfileImportStateIdFunc(resourceName),
+				ImportStateVerifyIdentifierAttribute: "user_identifier",
+				ImportStateVerifyIgnore:              []string{"user_type"},
+			},
+			{
+				Config: testAccUserProfileConfig_basic(rName, "DEACTIVATED"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckUserProfileExists(ctx, resourceName, &userprofile),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, "DEACTIVATED"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckUserProfileExists(ctx context.Context, name string, userprofile *datazone.GetUserProfileOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return create.Error(names.DataZone, create.ErrActionCheckingExistence, tfdatazone.ResNameUserProfile, name, errors.New("not found"))
+		}
+
+		if rs.Primary.ID == "" {
+			return create.Error(names.DataZone, create.ErrActionCheckingExistence, tfdatazone.ResNameUserProfile, name, errors.New("not set"))
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).DataZoneClient(ctx)
+		resp, err := tfdatazone.FindUserProfileByID(ctx, conn, rs.Primary.Attributes["domain_identifier"], rs.Primary.Attributes["user_identifier"], awstypes.UserProfileType(rs.Primary.Attributes[names.AttrType]))
+		if err != nil {
+			return create.Error(names.DataZone, create.ErrActionCheckingExistence, tfdatazone.ResNameUserProfile, rs.Primary.ID, err)
+		}
+
+		*userprofile = *resp
+
+		return nil
+	}
+}
+
+func testAccUserProfileImportStateIdFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		return fmt.Sprintf("%s/%s", rs.Primary.Attributes["domain_identifier"], rs.Primary.Attributes["user_identifier"]), nil
+	}
+}
+
+func testAccUserProfileConfig_basic(rName, status string) string {
+	return acctest.ConfigCompose(
+		testAccDomainConfig_basic(rName),
+		fmt.Sprintf(`
+resource "aws_iam_user" "test" {
+  name = %[1]q
+}
+
+resource "aws_datazone_user_profile" "test" {
+  domain_identifier = aws_datazone_domain.test.id
+  user_identifier   = aws_iam_user.test.arn
+  user_type         = "IAM_USER"
+  status            = %[2]q
+}
+`, rName, status),
+	)
+}
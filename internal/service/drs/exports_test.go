@@ -0,0 +1,13 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package drs
+
+// Exports for use in tests only.
+var (
+	ResourceLaunchConfigurationTemplate      = newLaunchConfigurationTemplateResource
+	ResourceReplicationConfigurationTemplate = newReplicationConfigurationTemplateResource
+
+	FindLaunchConfigurationTemplateByID      = findLaunchConfigurationTemplateByID
+	FindReplicationConfigurationTemplateByID = findReplicationConfigurationTemplateByID
+)
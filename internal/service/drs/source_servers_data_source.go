@@ -0,0 +1,151 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package drs
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/drs"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/drs/types"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource("aws_drs_source_servers", name="Source Servers")
+func newSourceServersDataSource(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &sourceServersDataSource{}, nil
+}
+
+type sourceServersDataSource struct {
+	framework.DataSourceWithConfigure
+}
+
+func (*sourceServersDataSource) Metadata(_ context.Context, request datasource.MetadataRequest, response *datasource.MetadataResponse) {
+	response.TypeName = "aws_drs_source_servers"
+}
+
+func (d *sourceServersDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"hardware_id": schema.StringAttribute{
+				Optional: true,
+			},
+			names.AttrID: framework.IDAttribute(),
+			"source_server_ids": schema.ListAttribute{
+				CustomType:  fwtypes.ListOfStringType,
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"source_servers": schema.ListAttribute{
+				CustomType:  fwtypes.NewListNestedObjectTypeOf[sourceServerModel](ctx),
+				ElementType: fwtypes.NewObjectTypeOf[sourceServerModel](ctx),
+				Computed:    true,
+			},
+			"staging_account_ids": schema.ListAttribute{
+				CustomType:  fwtypes.ListOfStringType,
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+		},
+	}
+}
+
+func (d *sourceServersDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data sourceServersDataSourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := d.Meta().DRSClient(ctx)
+
+	filters := &awstypes.DescribeSourceServersRequestFilters{}
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, filters)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	input := &drs.DescribeSourceServersInput{
+		Filters: filters,
+	}
+
+	output, err := findSourceServers(ctx, conn, input)
+
+	if err != nil {
+		response.Diagnostics.AddError("reading DRS Source Servers", err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data.SourceServers)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	data.ID = types.StringValue(d.Meta().Region)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func findSourceServers(ctx context.Context, conn *drs.Client, input *drs.DescribeSourceServersInput) ([]awstypes.SourceServer, error) {
+	var output []awstypes.SourceServer
+
+	pages := drs.NewDescribeSourceServersPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.Items...)
+	}
+
+	return output, nil
+}
+
+type sourceServersDataSourceModel struct {
+	HardwareID        types.String                                       `tfsdk:"hardware_id"`
+	ID                types.String                                       `tfsdk:"id"`
+	SourceServerIDs   fwtypes.ListValueOf[types.String]                  `tfsdk:"source_server_ids"`
+	SourceServers     fwtypes.ListNestedObjectValueOf[sourceServerModel] `tfsdk:"source_servers"`
+	StagingAccountIDs fwtypes.ListValueOf[types.String]                  `tfsdk:"staging_account_ids"`
+}
+
+type sourceServerModel struct {
+	AgentVersion         types.String                                              `tfsdk:"agent_version"`
+	ARN                  types.String                                              `tfsdk:"arn"`
+	DataReplicationInfo  fwtypes.ListNestedObjectValueOf[dataReplicationInfoModel] `tfsdk:"data_replication_info"`
+	LastLaunchResult     fwtypes.StringEnum[awstypes.LastLaunchResult]             `tfsdk:"last_launch_result"`
+	RecoveryInstanceID   types.String                                              `tfsdk:"recovery_instance_id"`
+	ReplicationDirection fwtypes.StringEnum[awstypes.ReplicationDirection]         `tfsdk:"replication_direction"`
+	SourceProperties     fwtypes.ListNestedObjectValueOf[sourcePropertiesModel]    `tfsdk:"source_properties"`
+	SourceServerID       types.String                                              `tfsdk:"source_server_id"`
+}
+
+type dataReplicationInfoModel struct {
+	DataReplicationState    fwtypes.StringEnum[awstypes.DataReplicationState] `tfsdk:"data_replication_state"`
+	EtaDateTime             types.String                                      `tfsdk:"eta_date_time"`
+	LagDuration             types.String                                      `tfsdk:"lag_duration"`
+	StagingAvailabilityZone types.String                                      `tfsdk:"staging_availability_zone"`
+}
+
+type sourcePropertiesModel struct {
+	IdentificationHints     fwtypes.ListNestedObjectValueOf[identificationHintsModel] `tfsdk:"identification_hints"`
+	LastUpdatedDateTime     types.String                                              `tfsdk:"last_updated_date_time"`
+	RAMBytes                types.Int64                                               `tfsdk:"ram_bytes"`
+	RecommendedInstanceType types.String                                              `tfsdk:"recommended_instance_type"`
+}
+
+type identificationHintsModel struct {
+	AWSInstanceID types.String `tfsdk:"aws_instance_id"`
+	FQDN          types.String `tfsdk:"fqdn"`
+	Hostname      types.String `tfsdk:"hostname"`
+	VMwareUUID    types.String `tfsdk:"vmware_uuid"`
+}
@@ -0,0 +1,73 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package drs_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccDRSSourceServersDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_drs_source_servers.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.DRSEndpointID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.DRSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSourceServersDataSourceConfig_basic(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, names.AttrID),
+					resource.TestCheckResourceAttrSet(dataSourceName, "source_servers.#"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDRSSourceServersDataSource_sourceServerIDs(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_drs_source_servers.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.DRSEndpointID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.DRSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSourceServersDataSourceConfig_sourceServerIDs(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "source_servers.#", acctest.Ct0),
+				),
+			},
+		},
+	})
+}
+
+func testAccSourceServersDataSourceConfig_basic() string {
+	return `
+data "aws_drs_source_servers" "test" {}
+`
+}
+
+func testAccSourceServersDataSourceConfig_sourceServerIDs() string {
+	return `
+data "aws_drs_source_servers" "test" {
+  source_server_ids = ["s-1234567890abcdef0"]
+}
+`
+}
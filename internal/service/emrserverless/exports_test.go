@@ -5,7 +5,13 @@ package emrserverless
 
 // Exports for use in tests only.
 var (
-	FindApplicationByID = findApplicationByID
+	FindApplicationByID    = findApplicationByID
+	FindJobRunByTwoPartKey = findJobRunByTwoPartKey
 
 	ResourceApplication = resourceApplication
+	ResourceJobRun      = resourceJobRun
+)
+
+const (
+	JobRunResourceIDPartCount = jobRunResourceIDPartCount
 )
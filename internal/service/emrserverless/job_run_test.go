@@ -0,0 +1,336 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package emrserverless_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/service/emrserverless/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tfemrserverless "github.com/hashicorp/terraform-provider-aws/internal/service/emrserverless"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccEMRServerlessJobRun_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var jobRun types.JobRun
+	resourceName := "aws_emrserverless_job_run.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EMRServerlessServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckJobRunDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccJobRunConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckJobRunExists(ctx, resourceName, &jobRun),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrApplicationID, "aws_emrserverless_application.test", names.AttrID),
+					acctest.MatchResourceAttrRegionalARN(resourceName, names.AttrARN, "emr-serverless", regexache.MustCompile(`/applications/.+/jobruns/.+$`)),
+					resource.TestCheckResourceAttr(resourceName, "billed_resource_utilization.#", acctest.Ct1),
+					resource.TestCheckResourceAttrSet(resourceName, "billed_resource_utilization.0.vcpu_hour"),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrExecutionRoleARN, "aws_iam_role.test", names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "job_driver.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "job_driver.0.spark_submit.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "job_driver.0.spark_submit.0.entry_point_arguments.#", acctest.Ct1),
+					resource.TestCheckResourceAttrSet(resourceName, "job_run_id"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+					resource.TestCheckResourceAttr(resourceName, "release_label", "emr-6.15.0"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrState, string(types.JobRunStateSuccess)),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct0),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccEMRServerlessJobRun_configurationOverrides(t *testing.T) {
+	ctx := acctest.Context(t)
+	var jobRun types.JobRun
+	resourceName := "aws_emrserverless_job_run.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EMRServerlessServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckJobRunDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccJobRunConfig_configurationOverrides(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckJobRunExists(ctx, resourceName, &jobRun),
+					resource.TestCheckResourceAttr(resourceName, "configuration_overrides.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "configuration_overrides.0.application_configuration.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "configuration_overrides.0.application_configuration.0.classification", "spark-defaults"),
+					resource.TestCheckResourceAttr(resourceName, "configuration_overrides.0.application_configuration.0.properties.%", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "configuration_overrides.0.monitoring_configuration.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "configuration_overrides.0.monitoring_configuration.0.s3_monitoring_configuration.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, names.AttrState, string(types.JobRunStateSuccess)),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccEMRServerlessJobRun_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	var jobRun types.JobRun
+	resourceName := "aws_emrserverless_job_run.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EMRServerlessServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckJobRunDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccJobRunConfig_tags1(rName, acctest.CtKey1, acctest.CtValue1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckJobRunExists(ctx, resourceName, &jobRun),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccJobRunConfig_tags2(rName, acctest.CtKey1, acctest.CtValue1Updated, acctest.CtKey2, acctest.CtValue2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckJobRunExists(ctx, resourceName, &jobRun),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct2),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1Updated),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey2, acctest.CtValue2),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckJobRunExists(ctx context.Context, n string, v *types.JobRun) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EMRServerlessClient(ctx)
+
+		parts, err := flex.ExpandResourceId(rs.Primary.ID, tfemrserverless.JobRunResourceIDPartCount, false)
+		if err != nil {
+			return err
+		}
+
+		output, err := tfemrserverless.FindJobRunByTwoPartKey(ctx, conn, parts[0], parts[1])
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+// Job runs can't be deleted, so a destroyed job run is one that is no longer in progress.
+func testAccCheckJobRunDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EMRServerlessClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_emrserverless_job_run" {
+				continue
+			}
+
+			parts, err := flex.ExpandResourceId(rs.Primary.ID, tfemrserverless.JobRunResourceIDPartCount, false)
+			if err != nil {
+				return err
+			}
+
+			output, err := tfemrserverless.FindJobRunByTwoPartKey(ctx, conn, parts[0], parts[1])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			switch output.State {
+			case types.JobRunStateSuccess, types.JobRunStateFailed, types.JobRunStateCancelled:
+				continue
+			}
+
+			return fmt.Errorf("EMR Serverless Job Run %s still in progress", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccJobRunConfig_base(rName string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_emrserverless_application" "test" {
+  name          = %[1]q
+  release_label = "emr-6.15.0"
+  type          = "spark"
+}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = "sts:AssumeRole"
+      Effect = "Allow"
+      Principal = {
+        Service = "emr-serverless.${data.aws_partition.current.dns_suffix}"
+      }
+    }]
+  })
+}
+`, rName)
+}
+
+func testAccJobRunConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccJobRunConfig_base(rName), fmt.Sprintf(`
+resource "aws_emrserverless_job_run" "test" {
+  application_id     = aws_emrserverless_application.test.id
+  execution_role_arn = aws_iam_role.test.arn
+  name               = %[1]q
+
+  job_driver {
+    spark_submit {
+      entry_point             = "local:///usr/lib/spark/examples/src/main/python/pi.py"
+      entry_point_arguments   = ["10"]
+      spark_submit_parameters = "--conf spark.executor.cores=1 --conf spark.executor.memory=2g --conf spark.driver.cores=1 --conf spark.driver.memory=2g"
+    }
+  }
+}
+`, rName))
+}
+
+func testAccJobRunConfig_configurationOverrides(rName string) string {
+	return acctest.ConfigCompose(testAccJobRunConfig_base(rName), fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_iam_role_policy" "test" {
+  name = %[1]q
+  role = aws_iam_role.test.id
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action   = ["s3:PutObject", "s3:GetObject", "s3:ListBucket"]
+      Effect   = "Allow"
+      Resource = [aws_s3_bucket.test.arn, "${aws_s3_bucket.test.arn}/*"]
+    }]
+  })
+}
+
+resource "aws_emrserverless_job_run" "test" {
+  application_id     = aws_emrserverless_application.test.id
+  execution_role_arn = aws_iam_role.test.arn
+  name               = %[1]q
+
+  job_driver {
+    spark_submit {
+      entry_point = "local:///usr/lib/spark/examples/src/main/python/pi.py"
+    }
+  }
+
+  configuration_overrides {
+    application_configuration {
+      classification = "spark-defaults"
+
+      properties = {
+        "spark.driver.cores" = "1"
+      }
+    }
+
+    monitoring_configuration {
+      s3_monitoring_configuration {
+        log_uri = "s3://${aws_s3_bucket.test.bucket}/logs/"
+      }
+    }
+  }
+
+  depends_on = [aws_iam_role_policy.test]
+}
+`, rName))
+}
+
+func testAccJobRunConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return acctest.ConfigCompose(testAccJobRunConfig_base(rName), fmt.Sprintf(`
+resource "aws_emrserverless_job_run" "test" {
+  application_id     = aws_emrserverless_application.test.id
+  execution_role_arn = aws_iam_role.test.arn
+  name               = %[1]q
+
+  job_driver {
+    spark_submit {
+      entry_point = "local:///usr/lib/spark/examples/src/main/python/pi.py"
+    }
+  }
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1))
+}
+
+func testAccJobRunConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return acctest.ConfigCompose(testAccJobRunConfig_base(rName), fmt.Sprintf(`
+resource "aws_emrserverless_job_run" "test" {
+  application_id     = aws_emrserverless_application.test.id
+  execution_role_arn = aws_iam_role.test.arn
+  name               = %[1]q
+
+  job_driver {
+    spark_submit {
+      entry_point = "local:///usr/lib/spark/examples/src/main/python/pi.py"
+    }
+  }
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2))
+}
@@ -0,0 +1,596 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package groundstation
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/groundstation"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/groundstation/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_groundstation_config", name="Config")
+// @Tags(identifierAttribute="arn")
+func newConfigResource(context.Context) (resource.ResourceWithConfigure, error) {
+	return &configResource{}, nil
+}
+
+type configResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+}
+
+func (*configResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_groundstation_config"
+}
+
+func (r *configResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	// Each config data variant is a separate block; exactly one must be configured.
+	// Switching between variants changes the config's type, which can't be updated in place.
+	configDataBlock := func(nestedObject schema.NestedBlockObject, customType basetypes.ListTypable, others ...string) schema.ListNestedBlock {
+		var expressions []path.Expression
+		for _, v := range others {
+			expressions = append(expressions, path.MatchRoot(v))
+		}
+
+		return schema.ListNestedBlock{
+			CustomType: customType,
+			PlanModifiers: []planmodifier.List{
+				listplanmodifier.RequiresReplaceIf(configTypeChangedIf, "Replace config on type change", "Replace config on type change"),
+			},
+			Validators: []validator.List{
+				listvalidator.SizeAtMost(1),
+				listvalidator.ExactlyOneOf(expressions...),
+			},
+			NestedObject: nestedObject,
+		}
+	}
+
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			"config_id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"config_type": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.ConfigCapabilityType](),
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+		},
+		Blocks: map[string]schema.Block{
+			"antenna_downlink_config": configDataBlock(schema.NestedBlockObject{
+				Blocks: map[string]schema.Block{
+					"spectrum_config": schema.ListNestedBlock{
+						CustomType: fwtypes.NewListNestedObjectTypeOf[spectrumConfigModel](ctx),
+						Validators: []validator.List{
+							listvalidator.IsRequired(),
+							listvalidator.SizeAtLeast(1),
+							listvalidator.SizeAtMost(1),
+						},
+						NestedObject: schema.NestedBlockObject{
+							Attributes: map[string]schema.Attribute{
+								"polarization": schema.StringAttribute{
+									CustomType: fwtypes.StringEnumType[awstypes.Polarization](),
+									Optional:   true,
+									Computed:   true,
+								},
+							},
+							Blocks: map[string]schema.Block{
+								"bandwidth": schema.ListNestedBlock{
+									CustomType: fwtypes.NewListNestedObjectTypeOf[frequencyBandwidthModel](ctx),
+									Validators: []validator.List{
+										listvalidator.IsRequired(),
+										listvalidator.SizeAtLeast(1),
+										listvalidator.SizeAtMost(1),
+									},
+									NestedObject: schema.NestedBlockObject{
+										Attributes: map[string]schema.Attribute{
+											"units": schema.StringAttribute{
+												CustomType: fwtypes.StringEnumType[awstypes.BandwidthUnits](),
+												Required:   true,
+											},
+											names.AttrValue: schema.Float64Attribute{
+												Required: true,
+											},
+										},
+									},
+								},
+								"center_frequency": schema.ListNestedBlock{
+									CustomType: fwtypes.NewListNestedObjectTypeOf[frequencyModel](ctx),
+									Validators: []validator.List{
+										listvalidator.IsRequired(),
+										listvalidator.SizeAtLeast(1),
+										listvalidator.SizeAtMost(1),
+									},
+									NestedObject: schema.NestedBlockObject{
+										Attributes: map[string]schema.Attribute{
+											"units": schema.StringAttribute{
+												CustomType: fwtypes.StringEnumType[awstypes.FrequencyUnits](),
+												Required:   true,
+											},
+											names.AttrValue: schema.Float64Attribute{
+												Required: true,
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			}, fwtypes.NewListNestedObjectTypeOf[antennaDownlinkConfigModel](ctx), "dataflow_endpoint_config", "s3_recording_config", "tracking_config", "uplink_echo_config"),
+			"dataflow_endpoint_config": configDataBlock(schema.NestedBlockObject{
+				Attributes: map[string]schema.Attribute{
+					"dataflow_endpoint_name": schema.StringAttribute{
+						Required: true,
+					},
+					"dataflow_endpoint_region": schema.StringAttribute{
+						Optional: true,
+						Computed: true,
+					},
+				},
+			}, fwtypes.NewListNestedObjectTypeOf[dataflowEndpointConfigModel](ctx), "antenna_downlink_config", "s3_recording_config", "tracking_config", "uplink_echo_config"),
+			"s3_recording_config": configDataBlock(schema.NestedBlockObject{
+				Attributes: map[string]schema.Attribute{
+					"bucket_arn": schema.StringAttribute{
+						CustomType: fwtypes.ARNType,
+						Required:   true,
+					},
+					names.AttrPrefix: schema.StringAttribute{
+						Optional: true,
+					},
+					names.AttrRoleARN: schema.StringAttribute{
+						CustomType: fwtypes.ARNType,
+						Required:   true,
+					},
+				},
+			}, fwtypes.NewListNestedObjectTypeOf[s3RecordingConfigModel](ctx), "antenna_downlink_config", "dataflow_endpoint_config", "tracking_config", "uplink_echo_config"),
+			"tracking_config": configDataBlock(schema.NestedBlockObject{
+				Attributes: map[string]schema.Attribute{
+					"autotrack": schema.StringAttribute{
+						CustomType: fwtypes.StringEnumType[awstypes.Criticality](),
+						Required:   true,
+					},
+				},
+			}, fwtypes.NewListNestedObjectTypeOf[trackingConfigModel](ctx), "antenna_downlink_config", "dataflow_endpoint_config", "s3_recording_config", "uplink_echo_config"),
+			"uplink_echo_config": configDataBlock(schema.NestedBlockObject{
+				Attributes: map[string]schema.Attribute{
+					"antenna_uplink_config_arn": schema.StringAttribute{
+						CustomType: fwtypes.ARNType,
+						Required:   true,
+					},
+					names.AttrEnabled: schema.BoolAttribute{
+						Required: true,
+					},
+				},
+			}, fwtypes.NewListNestedObjectTypeOf[uplinkEchoConfigModel](ctx), "antenna_downlink_config", "dataflow_endpoint_config", "s3_recording_config", "tracking_config"),
+		},
+	}
+}
+
+func (r *configResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data configResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().GroundStationClient(ctx)
+
+	configData, diags := data.expandConfigData(ctx)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	input := &groundstation.CreateConfigInput{
+		ConfigData: configData,
+		Name:       fwflex.StringFromFramework(ctx, data.Name),
+		Tags:       getTagsIn(ctx),
+	}
+
+	output, err := conn.CreateConfig(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating Ground Station Config (%s)", data.Name.ValueString()), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.ConfigARN = fwflex.StringToFramework(ctx, output.ConfigArn)
+	data.ConfigID = fwflex.StringToFramework(ctx, output.ConfigId)
+	data.ConfigType = fwtypes.StringEnumValue(output.ConfigType)
+	data.setID()
+
+	// Read back service-defaulted values.
+	config, err := findConfigByTwoPartKey(ctx, conn, data.ConfigID.ValueString(), data.ConfigType.ValueEnum())
+
+	if err != nil {
+		response.State.SetAttribute(ctx, path.Root(names.AttrID), data.ID) // Set 'id' so as to taint the resource.
+		response.Diagnostics.AddError(fmt.Sprintf("reading Ground Station Config (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(data.flattenConfigData(ctx, config.ConfigData)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *configResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data configResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if err := data.InitFromID(); err != nil {
+		response.Diagnostics.AddError("parsing resource ID", err.Error())
+
+		return
+	}
+
+	conn := r.Meta().GroundStationClient(ctx)
+
+	output, err := findConfigByTwoPartKey(ctx, conn, data.ConfigID.ValueString(), data.ConfigType.ValueEnum())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Ground Station Config (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(data.flattenConfigData(ctx, output.ConfigData)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	setTagsOut(ctx, output.Tags)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *configResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new configResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().GroundStationClient(ctx)
+
+	if !new.Name.Equal(old.Name) ||
+		!new.AntennaDownlinkConfig.Equal(old.AntennaDownlinkConfig) ||
+		!new.DataflowEndpointConfig.Equal(old.DataflowEndpointConfig) ||
+		!new.S3RecordingConfig.Equal(old.S3RecordingConfig) ||
+		!new.TrackingConfig.Equal(old.TrackingConfig) ||
+		!new.UplinkEchoConfig.Equal(old.UplinkEchoConfig) {
+		configData, diags := new.expandConfigData(ctx)
+		response.Diagnostics.Append(diags...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		input := &groundstation.UpdateConfigInput{
+			ConfigData: configData,
+			ConfigId:   fwflex.StringFromFramework(ctx, new.ConfigID),
+			ConfigType: new.ConfigType.ValueEnum(),
+			Name:       fwflex.StringFromFramework(ctx, new.Name),
+		}
+
+		_, err := conn.UpdateConfig(ctx, input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating Ground Station Config (%s)", new.ID.ValueString()), err.Error())
+
+			return
+		}
+
+		output, err := findConfigByTwoPartKey(ctx, conn, new.ConfigID.ValueString(), new.ConfigType.ValueEnum())
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("reading Ground Station Config (%s)", new.ID.ValueString()), err.Error())
+
+			return
+		}
+
+		response.Diagnostics.Append(new.flattenConfigData(ctx, output.ConfigData)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *configResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data configResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().GroundStationClient(ctx)
+
+	_, err := conn.DeleteConfig(ctx, &groundstation.DeleteConfigInput{
+		ConfigId:   aws.String(data.ConfigID.ValueString()),
+		ConfigType: data.ConfigType.ValueEnum(),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Ground Station Config (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func (r *configResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	r.SetTagsAll(ctx, request, response)
+}
+
+// configTypeChangedIf requires replacement when a config data block is added or removed,
+// i.e. when the config's type changes.
+func configTypeChangedIf(_ context.Context, request planmodifier.ListRequest, response *listplanmodifier.RequiresReplaceIfFuncResponse) {
+	response.RequiresReplace = len(request.StateValue.Elements()) != len(request.PlanValue.Elements())
+}
+
+func findConfigByTwoPartKey(ctx context.Context, conn *groundstation.Client, configID string, configType awstypes.ConfigCapabilityType) (*groundstation.GetConfigOutput, error) {
+	input := &groundstation.GetConfigInput{
+		ConfigId:   aws.String(configID),
+		ConfigType: configType,
+	}
+
+	output, err := conn.GetConfig(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+type configResourceModel struct {
+	AntennaDownlinkConfig  fwtypes.ListNestedObjectValueOf[antennaDownlinkConfigModel]  `tfsdk:"antenna_downlink_config"`
+	ConfigARN              types.String                                                 `tfsdk:"arn"`
+	ConfigID               types.String                                                 `tfsdk:"config_id"`
+	ConfigType             fwtypes.StringEnum[awstypes.ConfigCapabilityType]            `tfsdk:"config_type"`
+	DataflowEndpointConfig fwtypes.ListNestedObjectValueOf[dataflowEndpointConfigModel] `tfsdk:"dataflow_endpoint_config"`
+	ID                     types.String                                                 `tfsdk:"id"`
+	Name                   types.String                                                 `tfsdk:"name"`
+	S3RecordingConfig      fwtypes.ListNestedObjectValueOf[s3RecordingConfigModel]      `tfsdk:"s3_recording_config"`
+	Tags                   types.Map                                                    `tfsdk:"tags"`
+	TagsAll                types.Map                                                    `tfsdk:"tags_all"`
+	TrackingConfig         fwtypes.ListNestedObjectValueOf[trackingConfigModel]         `tfsdk:"tracking_config"`
+	UplinkEchoConfig       fwtypes.ListNestedObjectValueOf[uplinkEchoConfigModel]       `tfsdk:"uplink_echo_config"`
+}
+
+const (
+	configResourceIDPartCount = 2
+)
+
+func (data *configResourceModel) InitFromID() error {
+	parts, err := flex.ExpandResourceId(data.ID.ValueString(), configResourceIDPartCount, false)
+
+	if err != nil {
+		return err
+	}
+
+	data.ConfigID = types.StringValue(parts[0])
+	data.ConfigType = fwtypes.StringEnumValue(awstypes.ConfigCapabilityType(parts[1]))
+
+	return nil
+}
+
+func (data *configResourceModel) setID() {
+	data.ID = types.StringValue(errs.Must(flex.FlattenResourceId([]string{data.ConfigID.ValueString(), string(data.ConfigType.ValueEnum())}, configResourceIDPartCount, false)))
+}
+
+// expandConfigData expands whichever config data variant is configured into the API's union type.
+func (data *configResourceModel) expandConfigData(ctx context.Context) (awstypes.ConfigTypeData, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	switch {
+	case !data.AntennaDownlinkConfig.IsNull() && len(data.AntennaDownlinkConfig.Elements()) > 0:
+		tfObject, d := data.AntennaDownlinkConfig.ToPtr(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		var apiObject awstypes.ConfigTypeDataMemberAntennaDownlinkConfig
+		diags.Append(fwflex.Expand(ctx, tfObject, &apiObject.Value)...)
+
+		return &apiObject, diags
+
+	case !data.DataflowEndpointConfig.IsNull() && len(data.DataflowEndpointConfig.Elements()) > 0:
+		tfObject, d := data.DataflowEndpointConfig.ToPtr(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		var apiObject awstypes.ConfigTypeDataMemberDataflowEndpointConfig
+		diags.Append(fwflex.Expand(ctx, tfObject, &apiObject.Value)...)
+
+		return &apiObject, diags
+
+	case !data.S3RecordingConfig.IsNull() && len(data.S3RecordingConfig.Elements()) > 0:
+		tfObject, d := data.S3RecordingConfig.ToPtr(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		var apiObject awstypes.ConfigTypeDataMemberS3RecordingConfig
+		diags.Append(fwflex.Expand(ctx, tfObject, &apiObject.Value)...)
+
+		return &apiObject, diags
+
+	case !data.TrackingConfig.IsNull() && len(data.TrackingConfig.Elements()) > 0:
+		tfObject, d := data.TrackingConfig.ToPtr(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		var apiObject awstypes.ConfigTypeDataMemberTrackingConfig
+		diags.Append(fwflex.Expand(ctx, tfObject, &apiObject.Value)...)
+
+		return &apiObject, diags
+
+	case !data.UplinkEchoConfig.IsNull() && len(data.UplinkEchoConfig.Elements()) > 0:
+		tfObject, d := data.UplinkEchoConfig.ToPtr(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		var apiObject awstypes.ConfigTypeDataMemberUplinkEchoConfig
+		diags.Append(fwflex.Expand(ctx, tfObject, &apiObject.Value)...)
+
+		return &apiObject, diags
+	}
+
+	return nil, diags
+}
+
+// flattenConfigData flattens the API's config data union type into the matching variant block.
+func (data *configResourceModel) flattenConfigData(ctx context.Context, apiObject awstypes.ConfigTypeData) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	data.AntennaDownlinkConfig = fwtypes.NewListNestedObjectValueOfNull[antennaDownlinkConfigModel](ctx)
+	data.DataflowEndpointConfig = fwtypes.NewListNestedObjectValueOfNull[dataflowEndpointConfigModel](ctx)
+	data.S3RecordingConfig = fwtypes.NewListNestedObjectValueOfNull[s3RecordingConfigModel](ctx)
+	data.TrackingConfig = fwtypes.NewListNestedObjectValueOfNull[trackingConfigModel](ctx)
+	data.UplinkEchoConfig = fwtypes.NewListNestedObjectValueOfNull[uplinkEchoConfigModel](ctx)
+
+	switch v := apiObject.(type) {
+	case *awstypes.ConfigTypeDataMemberAntennaDownlinkConfig:
+		diags.Append(fwflex.Flatten(ctx, &v.Value, &data.AntennaDownlinkConfig)...)
+
+	case *awstypes.ConfigTypeDataMemberDataflowEndpointConfig:
+		diags.Append(fwflex.Flatten(ctx, &v.Value, &data.DataflowEndpointConfig)...)
+
+	case *awstypes.ConfigTypeDataMemberS3RecordingConfig:
+		diags.Append(fwflex.Flatten(ctx, &v.Value, &data.S3RecordingConfig)...)
+
+	case *awstypes.ConfigTypeDataMemberTrackingConfig:
+		diags.Append(fwflex.Flatten(ctx, &v.Value, &data.TrackingConfig)...)
+
+	case *awstypes.ConfigTypeDataMemberUplinkEchoConfig:
+		diags.Append(fwflex.Flatten(ctx, &v.Value, &data.UplinkEchoConfig)...)
+	}
+
+	return diags
+}
+
+type antennaDownlinkConfigModel struct {
+	SpectrumConfig fwtypes.ListNestedObjectValueOf[spectrumConfigModel] `tfsdk:"spectrum_config"`
+}
+
+type spectrumConfigModel struct {
+	Bandwidth       fwtypes.ListNestedObjectValueOf[frequencyBandwidthModel] `tfsdk:"bandwidth"`
+	CenterFrequency fwtypes.ListNestedObjectValueOf[frequencyModel]          `tfsdk:"center_frequency"`
+	Polarization    fwtypes.StringEnum[awstypes.Polarization]                `tfsdk:"polarization"`
+}
+
+type frequencyBandwidthModel struct {
+	Units fwtypes.StringEnum[awstypes.BandwidthUnits] `tfsdk:"units"`
+	Value types.Float64                               `tfsdk:"value"`
+}
+
+type frequencyModel struct {
+	Units fwtypes.StringEnum[awstypes.FrequencyUnits] `tfsdk:"units"`
+	Value types.Float64                               `tfsdk:"value"`
+}
+
+type dataflowEndpointConfigModel struct {
+	DataflowEndpointName   types.String `tfsdk:"dataflow_endpoint_name"`
+	DataflowEndpointRegion types.String `tfsdk:"dataflow_endpoint_region"`
+}
+
+type s3RecordingConfigModel struct {
+	BucketARN fwtypes.ARN  `tfsdk:"bucket_arn"`
+	Prefix    types.String `tfsdk:"prefix"`
+	RoleARN   fwtypes.ARN  `tfsdk:"role_arn"`
+}
+
+type trackingConfigModel struct {
+	Autotrack fwtypes.StringEnum[awstypes.Criticality] `tfsdk:"autotrack"`
+}
+
+type uplinkEchoConfigModel struct {
+	AntennaUplinkConfigARN fwtypes.ARN `tfsdk:"antenna_uplink_config_arn"`
+	Enabled                types.Bool  `tfsdk:"enabled"`
+}
@@ -0,0 +1,465 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package groundstation
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/groundstation"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/groundstation/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_groundstation_mission_profile", name="Mission Profile")
+// @Tags(identifierAttribute="arn")
+func newMissionProfileResource(context.Context) (resource.ResourceWithConfigure, error) {
+	return &missionProfileResource{}, nil
+}
+
+type missionProfileResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+}
+
+func (*missionProfileResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_groundstation_mission_profile"
+}
+
+func (r *missionProfileResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			"contact_post_pass_duration_seconds": schema.Int64Attribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.Int64{
+					int64validator.Between(0, 21600),
+				},
+			},
+			"contact_pre_pass_duration_seconds": schema.Int64Attribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.Int64{
+					int64validator.Between(0, 21600),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			"minimum_viable_contact_duration_seconds": schema.Int64Attribute{
+				Required: true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, 21600),
+				},
+			},
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+			},
+			"streams_kms_role": schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Optional:   true,
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+			"tracking_config_arn": schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Required:   true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"dataflow_edge": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[dataflowEdgeModel](ctx),
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						names.AttrDestination: schema.StringAttribute{
+							CustomType: fwtypes.ARNType,
+							Required:   true,
+						},
+						names.AttrSource: schema.StringAttribute{
+							CustomType: fwtypes.ARNType,
+							Required:   true,
+						},
+					},
+				},
+			},
+			"streams_kms_key": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[kmsKeyModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+					listvalidator.AlsoRequires(path.MatchRoot("streams_kms_role")),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"kms_alias_arn": schema.StringAttribute{
+							CustomType: fwtypes.ARNType,
+							Optional:   true,
+							Validators: []validator.String{
+								stringvalidator.ExactlyOneOf(
+									path.MatchRelative().AtParent().AtName("kms_alias_name"),
+									path.MatchRelative().AtParent().AtName(names.AttrKMSKeyARN),
+								),
+							},
+						},
+						"kms_alias_name": schema.StringAttribute{
+							Optional: true,
+						},
+						names.AttrKMSKeyARN: schema.StringAttribute{
+							CustomType: fwtypes.ARNType,
+							Optional:   true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *missionProfileResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data missionProfileResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().GroundStationClient(ctx)
+
+	input := &groundstation.CreateMissionProfileInput{}
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, input, missionProfileFlexOptions)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Additional fields.
+	var diags diag.Diagnostics
+	input.DataflowEdges, diags = data.expandDataflowEdges(ctx)
+	response.Diagnostics.Append(diags...)
+	input.StreamsKmsKey, diags = data.expandStreamsKMSKey(ctx)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	input.Tags = getTagsIn(ctx)
+
+	output, err := conn.CreateMissionProfile(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating Ground Station Mission Profile (%s)", data.Name.ValueString()), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.ID = fwflex.StringToFramework(ctx, output.MissionProfileId)
+
+	missionProfile, err := findMissionProfileByID(ctx, conn, data.ID.ValueString())
+
+	if err != nil {
+		response.State.SetAttribute(ctx, path.Root(names.AttrID), data.ID) // Set 'id' so as to taint the resource.
+		response.Diagnostics.AddError(fmt.Sprintf("reading Ground Station Mission Profile (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	data.ContactPostPassDurationSeconds = fwflex.Int32ToFramework(ctx, missionProfile.ContactPostPassDurationSeconds)
+	data.ContactPrePassDurationSeconds = fwflex.Int32ToFramework(ctx, missionProfile.ContactPrePassDurationSeconds)
+	data.MissionProfileARN = fwflex.StringToFramework(ctx, missionProfile.MissionProfileArn)
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *missionProfileResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data missionProfileResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().GroundStationClient(ctx)
+
+	output, err := findMissionProfileByID(ctx, conn, data.ID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Ground Station Mission Profile (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data, missionProfileFlexOptions)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(data.flattenDataflowEdges(ctx, output.DataflowEdges)...)
+	response.Diagnostics.Append(data.flattenStreamsKMSKey(ctx, output.StreamsKmsKey)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	setTagsOut(ctx, output.Tags)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *missionProfileResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new missionProfileResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().GroundStationClient(ctx)
+
+	if !new.ContactPostPassDurationSeconds.Equal(old.ContactPostPassDurationSeconds) ||
+		!new.ContactPrePassDurationSeconds.Equal(old.ContactPrePassDurationSeconds) ||
+		!new.DataflowEdges.Equal(old.DataflowEdges) ||
+		!new.MinimumViableContactDurationSeconds.Equal(old.MinimumViableContactDurationSeconds) ||
+		!new.Name.Equal(old.Name) ||
+		!new.StreamsKmsKey.Equal(old.StreamsKmsKey) ||
+		!new.StreamsKMSRole.Equal(old.StreamsKMSRole) ||
+		!new.TrackingConfigARN.Equal(old.TrackingConfigARN) {
+		input := &groundstation.UpdateMissionProfileInput{}
+		response.Diagnostics.Append(fwflex.Expand(ctx, new, input, missionProfileFlexOptions)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		// Additional fields.
+		input.MissionProfileId = aws.String(new.ID.ValueString())
+		var diags diag.Diagnostics
+		input.DataflowEdges, diags = new.expandDataflowEdges(ctx)
+		response.Diagnostics.Append(diags...)
+		input.StreamsKmsKey, diags = new.expandStreamsKMSKey(ctx)
+		response.Diagnostics.Append(diags...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		_, err := conn.UpdateMissionProfile(ctx, input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating Ground Station Mission Profile (%s)", new.ID.ValueString()), err.Error())
+
+			return
+		}
+
+		output, err := findMissionProfileByID(ctx, conn, new.ID.ValueString())
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("reading Ground Station Mission Profile (%s)", new.ID.ValueString()), err.Error())
+
+			return
+		}
+
+		new.ContactPostPassDurationSeconds = fwflex.Int32ToFramework(ctx, output.ContactPostPassDurationSeconds)
+		new.ContactPrePassDurationSeconds = fwflex.Int32ToFramework(ctx, output.ContactPrePassDurationSeconds)
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *missionProfileResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data missionProfileResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().GroundStationClient(ctx)
+
+	_, err := conn.DeleteMissionProfile(ctx, &groundstation.DeleteMissionProfileInput{
+		MissionProfileId: aws.String(data.ID.ValueString()),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Ground Station Mission Profile (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func (r *missionProfileResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	r.SetTagsAll(ctx, request, response)
+}
+
+// missionProfileFlexOptions excludes the fields that AutoFlEx can't handle:
+// dataflow edges are a list of [source, destination] pairs and the KMS key is a union.
+func missionProfileFlexOptions(o *fwflex.AutoFlexOptions) {
+	o.AddIgnoredField("DataflowEdges")
+	o.AddIgnoredField("StreamsKmsKey")
+}
+
+func findMissionProfileByID(ctx context.Context, conn *groundstation.Client, id string) (*groundstation.GetMissionProfileOutput, error) {
+	input := &groundstation.GetMissionProfileInput{
+		MissionProfileId: aws.String(id),
+	}
+
+	output, err := conn.GetMissionProfile(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+type missionProfileResourceModel struct {
+	ContactPostPassDurationSeconds      types.Int64                                        `tfsdk:"contact_post_pass_duration_seconds"`
+	ContactPrePassDurationSeconds       types.Int64                                        `tfsdk:"contact_pre_pass_duration_seconds"`
+	DataflowEdges                       fwtypes.ListNestedObjectValueOf[dataflowEdgeModel] `tfsdk:"dataflow_edge"`
+	ID                                  types.String                                       `tfsdk:"id"`
+	MinimumViableContactDurationSeconds types.Int64                                        `tfsdk:"minimum_viable_contact_duration_seconds"`
+	MissionProfileARN                   types.String                                       `tfsdk:"arn"`
+	Name                                types.String                                       `tfsdk:"name"`
+	StreamsKmsKey                       fwtypes.ListNestedObjectValueOf[kmsKeyModel]       `tfsdk:"streams_kms_key"`
+	StreamsKMSRole                      fwtypes.ARN                                        `tfsdk:"streams_kms_role"`
+	Tags                                types.Map                                          `tfsdk:"tags"`
+	TagsAll                             types.Map                                          `tfsdk:"tags_all"`
+	TrackingConfigARN                   fwtypes.ARN                                        `tfsdk:"tracking_config_arn"`
+}
+
+func (data *missionProfileResourceModel) expandDataflowEdges(ctx context.Context) ([][]string, diag.Diagnostics) {
+	tfObjects, diags := data.DataflowEdges.ToSlice(ctx)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	apiObjects := make([][]string, 0, len(tfObjects))
+	for _, tfObject := range tfObjects {
+		apiObjects = append(apiObjects, []string{tfObject.Source.ValueString(), tfObject.Destination.ValueString()})
+	}
+
+	return apiObjects, diags
+}
+
+func (data *missionProfileResourceModel) flattenDataflowEdges(ctx context.Context, apiObjects [][]string) diag.Diagnostics {
+	tfObjects := make([]*dataflowEdgeModel, 0, len(apiObjects))
+	for _, apiObject := range apiObjects {
+		if len(apiObject) != 2 {
+			continue
+		}
+
+		tfObjects = append(tfObjects, &dataflowEdgeModel{
+			Destination: fwtypes.ARNValue(apiObject[1]),
+			Source:      fwtypes.ARNValue(apiObject[0]),
+		})
+	}
+
+	var diags diag.Diagnostics
+	data.DataflowEdges, diags = fwtypes.NewListNestedObjectValueOfSlice(ctx, tfObjects)
+
+	return diags
+}
+
+func (data *missionProfileResourceModel) expandStreamsKMSKey(ctx context.Context) (awstypes.KmsKey, diag.Diagnostics) {
+	tfObject, diags := data.StreamsKmsKey.ToPtr(ctx)
+	if diags.HasError() || tfObject == nil {
+		return nil, diags
+	}
+
+	switch {
+	case !tfObject.KMSAliasARN.IsNull():
+		return &awstypes.KmsKeyMemberKmsAliasArn{Value: tfObject.KMSAliasARN.ValueString()}, diags
+	case !tfObject.KMSAliasName.IsNull():
+		return &awstypes.KmsKeyMemberKmsAliasName{Value: tfObject.KMSAliasName.ValueString()}, diags
+	case !tfObject.KMSKeyARN.IsNull():
+		return &awstypes.KmsKeyMemberKmsKeyArn{Value: tfObject.KMSKeyARN.ValueString()}, diags
+	}
+
+	return nil, diags
+}
+
+func (data *missionProfileResourceModel) flattenStreamsKMSKey(ctx context.Context, apiObject awstypes.KmsKey) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if apiObject == nil {
+		data.StreamsKmsKey = fwtypes.NewListNestedObjectValueOfNull[kmsKeyModel](ctx)
+
+		return diags
+	}
+
+	tfObject := &kmsKeyModel{
+		KMSAliasARN:  fwtypes.ARNNull(),
+		KMSAliasName: types.StringNull(),
+		KMSKeyARN:    fwtypes.ARNNull(),
+	}
+
+	switch v := apiObject.(type) {
+	case *awstypes.KmsKeyMemberKmsAliasArn:
+		tfObject.KMSAliasARN = fwtypes.ARNValue(v.Value)
+	case *awstypes.KmsKeyMemberKmsAliasName:
+		tfObject.KMSAliasName = types.StringValue(v.Value)
+	case *awstypes.KmsKeyMemberKmsKeyArn:
+		tfObject.KMSKeyARN = fwtypes.ARNValue(v.Value)
+	}
+
+	data.StreamsKmsKey = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, tfObject)
+
+	return diags
+}
+
+type dataflowEdgeModel struct {
+	Destination fwtypes.ARN `tfsdk:"destination"`
+	Source      fwtypes.ARN `tfsdk:"source"`
+}
+
+type kmsKeyModel struct {
+	KMSAliasARN  fwtypes.ARN  `tfsdk:"kms_alias_arn"`
+	KMSAliasName types.String `tfsdk:"kms_alias_name"`
+	KMSKeyARN    fwtypes.ARN  `tfsdk:"kms_key_arn"`
+}
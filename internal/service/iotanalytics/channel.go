@@ -0,0 +1,346 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iotanalytics
+
+import (
+	"context"
+	"log"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/iotanalytics"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/iotanalytics/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_iotanalytics_channel", name="Channel")
+// @Tags(identifierAttribute="arn")
+func resourceChannel() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceChannelCreate,
+		ReadWithoutTimeout:   resourceChannelRead,
+		UpdateWithoutTimeout: resourceChannelUpdate,
+		DeleteWithoutTimeout: resourceChannelDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrName: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validName,
+			},
+			"retention_period": retentionPeriodSchema(),
+			"storage": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"customer_managed_s3": customerManagedS3Schema(),
+						"service_managed_s3": {
+							Type:          schema.TypeList,
+							Optional:      true,
+							MaxItems:      1,
+							ConflictsWith: []string{"storage.0.customer_managed_s3"},
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{},
+							},
+						},
+					},
+				},
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+var validName = validation.All(
+	validation.StringLenBetween(1, 128),
+	validation.StringMatch(regexache.MustCompile(`^[0-9A-Za-z_]+$`), "must contain only alphanumeric characters and underscores"),
+)
+
+func retentionPeriodSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Computed: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"number_of_days": {
+					Type:          schema.TypeInt,
+					Optional:      true,
+					ValidateFunc:  validation.IntAtLeast(1),
+					ConflictsWith: []string{"retention_period.0.unlimited"},
+				},
+				"unlimited": {
+					Type:          schema.TypeBool,
+					Optional:      true,
+					ConflictsWith: []string{"retention_period.0.number_of_days"},
+				},
+			},
+		},
+	}
+}
+
+func customerManagedS3Schema() *schema.Schema {
+	return &schema.Schema{
+		Type:          schema.TypeList,
+		Optional:      true,
+		MaxItems:      1,
+		ConflictsWith: []string{"storage.0.service_managed_s3"},
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				names.AttrBucket: {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+				"key_prefix": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.StringMatch(regexache.MustCompile(`^[0-9A-Za-z_./!*'()-]*/$`), "must end with a forward slash (/)"),
+				},
+				names.AttrRoleARN: {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: verify.ValidARN,
+				},
+			},
+		},
+	}
+}
+
+func resourceChannelCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IoTAnalyticsClient(ctx)
+
+	name := d.Get(names.AttrName).(string)
+	input := &iotanalytics.CreateChannelInput{
+		ChannelName: aws.String(name),
+		Tags:        getTagsIn(ctx),
+	}
+
+	if v, ok := d.GetOk("retention_period"); ok {
+		input.RetentionPeriod = expandRetentionPeriod(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("storage"); ok {
+		input.ChannelStorage = expandChannelStorage(v.([]interface{}))
+	}
+
+	_, err := conn.CreateChannel(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating IoT Analytics Channel (%s): %s", name, err)
+	}
+
+	d.SetId(name)
+
+	return append(diags, resourceChannelRead(ctx, d, meta)...)
+}
+
+func resourceChannelRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IoTAnalyticsClient(ctx)
+
+	channel, err := findChannelByName(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] IoT Analytics Channel (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading IoT Analytics Channel (%s): %s", d.Id(), err)
+	}
+
+	d.Set(names.AttrARN, channel.Arn)
+	d.Set(names.AttrName, channel.Name)
+	if err := d.Set("retention_period", flattenRetentionPeriod(channel.RetentionPeriod)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting retention_period: %s", err)
+	}
+	if err := d.Set("storage", flattenChannelStorage(channel.Storage)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting storage: %s", err)
+	}
+
+	return diags
+}
+
+func resourceChannelUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IoTAnalyticsClient(ctx)
+
+	if d.HasChangesExcept(names.AttrTags, names.AttrTagsAll) {
+		input := &iotanalytics.UpdateChannelInput{
+			ChannelName: aws.String(d.Id()),
+		}
+
+		if v, ok := d.GetOk("retention_period"); ok {
+			input.RetentionPeriod = expandRetentionPeriod(v.([]interface{}))
+		}
+
+		if v, ok := d.GetOk("storage"); ok {
+			input.ChannelStorage = expandChannelStorage(v.([]interface{}))
+		}
+
+		_, err := conn.UpdateChannel(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating IoT Analytics Channel (%s): %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceChannelRead(ctx, d, meta)...)
+}
+
+func resourceChannelDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IoTAnalyticsClient(ctx)
+
+	log.Printf("[DEBUG] Deleting IoT Analytics Channel: %s", d.Id())
+	_, err := conn.DeleteChannel(ctx, &iotanalytics.DeleteChannelInput{
+		ChannelName: aws.String(d.Id()),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting IoT Analytics Channel (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func findChannelByName(ctx context.Context, conn *iotanalytics.Client, name string) (*awstypes.Channel, error) {
+	input := &iotanalytics.DescribeChannelInput{
+		ChannelName: aws.String(name),
+	}
+
+	output, err := conn.DescribeChannel(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Channel == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Channel, nil
+}
+
+func expandRetentionPeriod(tfList []interface{}) *awstypes.RetentionPeriod {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	apiObject := &awstypes.RetentionPeriod{}
+
+	if v, ok := tfMap["number_of_days"].(int); ok && v != 0 {
+		apiObject.NumberOfDays = aws.Int32(int32(v))
+	}
+
+	if v, ok := tfMap["unlimited"].(bool); ok && v {
+		apiObject.Unlimited = v
+	}
+
+	return apiObject
+}
+
+func expandChannelStorage(tfList []interface{}) *awstypes.ChannelStorage {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	apiObject := &awstypes.ChannelStorage{}
+
+	if v, ok := tfMap["customer_managed_s3"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		apiObject.CustomerManagedS3 = &awstypes.CustomerManagedChannelS3Storage{
+			Bucket:  aws.String(tfMap[names.AttrBucket].(string)),
+			RoleArn: aws.String(tfMap[names.AttrRoleARN].(string)),
+		}
+
+		if v, ok := tfMap["key_prefix"].(string); ok && v != "" {
+			apiObject.CustomerManagedS3.KeyPrefix = aws.String(v)
+		}
+	}
+
+	if v, ok := tfMap["service_managed_s3"].([]interface{}); ok && len(v) > 0 {
+		apiObject.ServiceManagedS3 = &awstypes.ServiceManagedChannelS3Storage{}
+	}
+
+	return apiObject
+}
+
+func flattenRetentionPeriod(apiObject *awstypes.RetentionPeriod) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"number_of_days": aws.ToInt32(apiObject.NumberOfDays),
+		"unlimited":      apiObject.Unlimited,
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenChannelStorage(apiObject *awstypes.ChannelStorage) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.CustomerManagedS3; v != nil {
+		tfMap["customer_managed_s3"] = []interface{}{map[string]interface{}{
+			names.AttrBucket:  aws.ToString(v.Bucket),
+			"key_prefix":      aws.ToString(v.KeyPrefix),
+			names.AttrRoleARN: aws.ToString(v.RoleArn),
+		}}
+	}
+
+	if v := apiObject.ServiceManagedS3; v != nil {
+		tfMap["service_managed_s3"] = []interface{}{map[string]interface{}{}}
+	}
+
+	return []interface{}{tfMap}
+}
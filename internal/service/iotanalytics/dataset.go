@@ -0,0 +1,990 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iotanalytics
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/iotanalytics"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/iotanalytics/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_iotanalytics_dataset", name="Dataset")
+// @Tags(identifierAttribute="arn")
+func resourceDataset() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceDatasetCreate,
+		ReadWithoutTimeout:   resourceDatasetRead,
+		UpdateWithoutTimeout: resourceDatasetUpdate,
+		DeleteWithoutTimeout: resourceDatasetDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			names.AttrAction: {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"container_action": {
+							Type:         schema.TypeList,
+							Optional:     true,
+							MaxItems:     1,
+							ExactlyOneOf: []string{"action.0.container_action", "action.0.query_action"},
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									names.AttrExecutionRoleARN: {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+									"image": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringLenBetween(1, 255),
+									},
+									"resource_configuration": {
+										Type:     schema.TypeList,
+										Required: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"compute_type": {
+													Type:             schema.TypeString,
+													Required:         true,
+													ValidateDiagFunc: enum.Validate[awstypes.ComputeType](),
+												},
+												"volume_size_in_gb": {
+													Type:         schema.TypeInt,
+													Required:     true,
+													ValidateFunc: validation.IntBetween(1, 50),
+												},
+											},
+										},
+									},
+									"variable": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 50,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"dataset_content_version_value": {
+													Type:     schema.TypeList,
+													Optional: true,
+													MaxItems: 1,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"dataset_name": {
+																Type:         schema.TypeString,
+																Required:     true,
+																ValidateFunc: validName,
+															},
+														},
+													},
+												},
+												"double_value": {
+													Type:     schema.TypeFloat,
+													Optional: true,
+												},
+												names.AttrName: {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringLenBetween(1, 256),
+												},
+												"output_file_uri_value": {
+													Type:     schema.TypeList,
+													Optional: true,
+													MaxItems: 1,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"file_name": {
+																Type:         schema.TypeString,
+																Required:     true,
+																ValidateFunc: validation.StringLenBetween(1, 255),
+															},
+														},
+													},
+												},
+												"string_value": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													ValidateFunc: validation.StringLenBetween(0, 1024),
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						names.AttrName: {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 128),
+						},
+						"query_action": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									names.AttrFilter: {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"delta_time": {
+													Type:     schema.TypeList,
+													Required: true,
+													MaxItems: 1,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"offset_seconds": {
+																Type:     schema.TypeInt,
+																Required: true,
+															},
+															"time_expression": {
+																Type:     schema.TypeString,
+																Required: true,
+															},
+														},
+													},
+												},
+											},
+										},
+									},
+									"sql_query": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"content_delivery_rule": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 20,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrDestination: {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"iot_events_destination_configuration": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"input_name": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringLenBetween(1, 128),
+												},
+												names.AttrRoleARN: {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: verify.ValidARN,
+												},
+											},
+										},
+									},
+									"s3_destination_configuration": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												names.AttrBucket: {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringLenBetween(3, 255),
+												},
+												"glue_configuration": {
+													Type:     schema.TypeList,
+													Optional: true,
+													MaxItems: 1,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															names.AttrDatabaseName: {
+																Type:         schema.TypeString,
+																Required:     true,
+																ValidateFunc: validation.StringLenBetween(1, 150),
+															},
+															names.AttrTableName: {
+																Type:         schema.TypeString,
+																Required:     true,
+																ValidateFunc: validation.StringLenBetween(1, 150),
+															},
+														},
+													},
+												},
+												names.AttrKey: {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringLenBetween(1, 255),
+												},
+												names.AttrRoleARN: {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: verify.ValidARN,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"entry_name": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"late_data_rule": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"rule_configuration": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"delta_time_session_window_configuration": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"timeout_in_minutes": {
+													Type:         schema.TypeInt,
+													Required:     true,
+													ValidateFunc: validation.IntBetween(1, 60),
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"rule_name": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validName,
+						},
+					},
+				},
+			},
+			names.AttrName: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validName,
+			},
+			"retention_period": retentionPeriodSchema(),
+			names.AttrTags:     tftags.TagsSchema(),
+			names.AttrTagsAll:  tftags.TagsSchemaComputed(),
+			"trigger": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"dataset": {
+							Type:         schema.TypeList,
+							Optional:     true,
+							MaxItems:     1,
+							ExactlyOneOf: []string{"trigger.0.dataset", "trigger.0.schedule"},
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									names.AttrName: {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validName,
+									},
+								},
+							},
+						},
+						names.AttrSchedule: {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									names.AttrExpression: {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"versioning_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"max_versions": {
+							Type:          schema.TypeInt,
+							Optional:      true,
+							ValidateFunc:  validation.IntBetween(1, 1000),
+							ConflictsWith: []string{"versioning_configuration.0.unlimited"},
+						},
+						"unlimited": {
+							Type:          schema.TypeBool,
+							Optional:      true,
+							ConflictsWith: []string{"versioning_configuration.0.max_versions"},
+						},
+					},
+				},
+			},
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceDatasetCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IoTAnalyticsClient(ctx)
+
+	name := d.Get(names.AttrName).(string)
+	input := &iotanalytics.CreateDatasetInput{
+		Actions:     expandDatasetActions(d.Get(names.AttrAction).([]interface{})),
+		DatasetName: aws.String(name),
+		Tags:        getTagsIn(ctx),
+	}
+
+	if v, ok := d.GetOk("content_delivery_rule"); ok {
+		input.ContentDeliveryRules = expandDatasetContentDeliveryRules(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("late_data_rule"); ok {
+		input.LateDataRules = expandLateDataRules(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("retention_period"); ok {
+		input.RetentionPeriod = expandRetentionPeriod(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("trigger"); ok {
+		input.Triggers = expandDatasetTriggers(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("versioning_configuration"); ok {
+		input.VersioningConfiguration = expandVersioningConfiguration(v.([]interface{}))
+	}
+
+	_, err := conn.CreateDataset(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating IoT Analytics Dataset (%s): %s", name, err)
+	}
+
+	d.SetId(name)
+
+	return append(diags, resourceDatasetRead(ctx, d, meta)...)
+}
+
+func resourceDatasetRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IoTAnalyticsClient(ctx)
+
+	dataset, err := findDatasetByName(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] IoT Analytics Dataset (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading IoT Analytics Dataset (%s): %s", d.Id(), err)
+	}
+
+	if err := d.Set(names.AttrAction, flattenDatasetActions(dataset.Actions)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting action: %s", err)
+	}
+	d.Set(names.AttrARN, dataset.Arn)
+	if err := d.Set("content_delivery_rule", flattenDatasetContentDeliveryRules(dataset.ContentDeliveryRules)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting content_delivery_rule: %s", err)
+	}
+	if err := d.Set("late_data_rule", flattenLateDataRules(dataset.LateDataRules)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting late_data_rule: %s", err)
+	}
+	d.Set(names.AttrName, dataset.Name)
+	if err := d.Set("retention_period", flattenRetentionPeriod(dataset.RetentionPeriod)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting retention_period: %s", err)
+	}
+	if err := d.Set("trigger", flattenDatasetTriggers(dataset.Triggers)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting trigger: %s", err)
+	}
+	if err := d.Set("versioning_configuration", flattenVersioningConfiguration(dataset.VersioningConfiguration)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting versioning_configuration: %s", err)
+	}
+
+	return diags
+}
+
+func resourceDatasetUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IoTAnalyticsClient(ctx)
+
+	if d.HasChangesExcept(names.AttrTags, names.AttrTagsAll) {
+		input := &iotanalytics.UpdateDatasetInput{
+			Actions:     expandDatasetActions(d.Get(names.AttrAction).([]interface{})),
+			DatasetName: aws.String(d.Id()),
+		}
+
+		if v, ok := d.GetOk("content_delivery_rule"); ok {
+			input.ContentDeliveryRules = expandDatasetContentDeliveryRules(v.([]interface{}))
+		}
+
+		if v, ok := d.GetOk("late_data_rule"); ok {
+			input.LateDataRules = expandLateDataRules(v.([]interface{}))
+		}
+
+		if v, ok := d.GetOk("retention_period"); ok {
+			input.RetentionPeriod = expandRetentionPeriod(v.([]interface{}))
+		}
+
+		if v, ok := d.GetOk("trigger"); ok {
+			input.Triggers = expandDatasetTriggers(v.([]interface{}))
+		}
+
+		if v, ok := d.GetOk("versioning_configuration"); ok {
+			input.VersioningConfiguration = expandVersioningConfiguration(v.([]interface{}))
+		}
+
+		_, err := conn.UpdateDataset(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating IoT Analytics Dataset (%s): %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceDatasetRead(ctx, d, meta)...)
+}
+
+func resourceDatasetDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IoTAnalyticsClient(ctx)
+
+	log.Printf("[DEBUG] Deleting IoT Analytics Dataset: %s", d.Id())
+	_, err := conn.DeleteDataset(ctx, &iotanalytics.DeleteDatasetInput{
+		DatasetName: aws.String(d.Id()),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting IoT Analytics Dataset (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func findDatasetByName(ctx context.Context, conn *iotanalytics.Client, name string) (*awstypes.Dataset, error) {
+	input := &iotanalytics.DescribeDatasetInput{
+		DatasetName: aws.String(name),
+	}
+
+	output, err := conn.DescribeDataset(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Dataset == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Dataset, nil
+}
+
+func expandDatasetActions(tfList []interface{}) []awstypes.DatasetAction {
+	var apiObjects []awstypes.DatasetAction
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := awstypes.DatasetAction{
+			ActionName: aws.String(tfMap[names.AttrName].(string)),
+		}
+
+		if v, ok := tfMap["container_action"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.ContainerAction = expandContainerDatasetAction(v[0].(map[string]interface{}))
+		}
+
+		if v, ok := tfMap["query_action"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.QueryAction = expandSQLQueryDatasetAction(v[0].(map[string]interface{}))
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandContainerDatasetAction(tfMap map[string]interface{}) *awstypes.ContainerDatasetAction {
+	apiObject := &awstypes.ContainerDatasetAction{
+		ExecutionRoleArn: aws.String(tfMap[names.AttrExecutionRoleARN].(string)),
+		Image:            aws.String(tfMap["image"].(string)),
+	}
+
+	if v, ok := tfMap["resource_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		apiObject.ResourceConfiguration = &awstypes.ResourceConfiguration{
+			ComputeType:    awstypes.ComputeType(tfMap["compute_type"].(string)),
+			VolumeSizeInGB: aws.Int32(int32(tfMap["volume_size_in_gb"].(int))),
+		}
+	}
+
+	for _, tfMapRaw := range tfMap["variable"].([]interface{}) {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		variable := awstypes.Variable{
+			Name: aws.String(tfMap[names.AttrName].(string)),
+		}
+
+		if v, ok := tfMap["dataset_content_version_value"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			variable.DatasetContentVersionValue = &awstypes.DatasetContentVersionValue{
+				DatasetName: aws.String(v[0].(map[string]interface{})["dataset_name"].(string)),
+			}
+		}
+
+		if v, ok := tfMap["double_value"].(float64); ok && v != 0 {
+			variable.DoubleValue = aws.Float64(v)
+		}
+
+		if v, ok := tfMap["output_file_uri_value"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			variable.OutputFileUriValue = &awstypes.OutputFileUriValue{
+				FileName: aws.String(v[0].(map[string]interface{})["file_name"].(string)),
+			}
+		}
+
+		if v, ok := tfMap["string_value"].(string); ok && v != "" {
+			variable.StringValue = aws.String(v)
+		}
+
+		apiObject.Variables = append(apiObject.Variables, variable)
+	}
+
+	return apiObject
+}
+
+func expandSQLQueryDatasetAction(tfMap map[string]interface{}) *awstypes.SqlQueryDatasetAction {
+	apiObject := &awstypes.SqlQueryDatasetAction{
+		SqlQuery: aws.String(tfMap["sql_query"].(string)),
+	}
+
+	for _, tfMapRaw := range tfMap[names.AttrFilter].([]interface{}) {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		filter := awstypes.QueryFilter{}
+
+		if v, ok := tfMap["delta_time"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+			filter.DeltaTime = &awstypes.DeltaTime{
+				OffsetSeconds:  aws.Int32(int32(tfMap["offset_seconds"].(int))),
+				TimeExpression: aws.String(tfMap["time_expression"].(string)),
+			}
+		}
+
+		apiObject.Filters = append(apiObject.Filters, filter)
+	}
+
+	return apiObject
+}
+
+func expandDatasetContentDeliveryRules(tfList []interface{}) []awstypes.DatasetContentDeliveryRule {
+	var apiObjects []awstypes.DatasetContentDeliveryRule
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := awstypes.DatasetContentDeliveryRule{
+			Destination: &awstypes.DatasetContentDeliveryDestination{},
+		}
+
+		if v, ok := tfMap["entry_name"].(string); ok && v != "" {
+			apiObject.EntryName = aws.String(v)
+		}
+
+		if v, ok := tfMap[names.AttrDestination].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+
+			if v, ok := tfMap["iot_events_destination_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+				tfMap := v[0].(map[string]interface{})
+				apiObject.Destination.IotEventsDestinationConfiguration = &awstypes.IotEventsDestinationConfiguration{
+					InputName: aws.String(tfMap["input_name"].(string)),
+					RoleArn:   aws.String(tfMap[names.AttrRoleARN].(string)),
+				}
+			}
+
+			if v, ok := tfMap["s3_destination_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+				tfMap := v[0].(map[string]interface{})
+				s3Configuration := &awstypes.S3DestinationConfiguration{
+					Bucket:  aws.String(tfMap[names.AttrBucket].(string)),
+					Key:     aws.String(tfMap[names.AttrKey].(string)),
+					RoleArn: aws.String(tfMap[names.AttrRoleARN].(string)),
+				}
+
+				if v, ok := tfMap["glue_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+					tfMap := v[0].(map[string]interface{})
+					s3Configuration.GlueConfiguration = &awstypes.GlueConfiguration{
+						DatabaseName: aws.String(tfMap[names.AttrDatabaseName].(string)),
+						TableName:    aws.String(tfMap[names.AttrTableName].(string)),
+					}
+				}
+
+				apiObject.Destination.S3DestinationConfiguration = s3Configuration
+			}
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandLateDataRules(tfList []interface{}) []awstypes.LateDataRule {
+	var apiObjects []awstypes.LateDataRule
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := awstypes.LateDataRule{
+			RuleConfiguration: &awstypes.LateDataRuleConfiguration{},
+		}
+
+		if v, ok := tfMap["rule_name"].(string); ok && v != "" {
+			apiObject.RuleName = aws.String(v)
+		}
+
+		if v, ok := tfMap["rule_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+
+			if v, ok := tfMap["delta_time_session_window_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+				apiObject.RuleConfiguration.DeltaTimeSessionWindowConfiguration = &awstypes.DeltaTimeSessionWindowConfiguration{
+					TimeoutInMinutes: aws.Int32(int32(v[0].(map[string]interface{})["timeout_in_minutes"].(int))),
+				}
+			}
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandDatasetTriggers(tfList []interface{}) []awstypes.DatasetTrigger {
+	var apiObjects []awstypes.DatasetTrigger
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := awstypes.DatasetTrigger{}
+
+		if v, ok := tfMap["dataset"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.Dataset = &awstypes.TriggeringDataset{
+				Name: aws.String(v[0].(map[string]interface{})[names.AttrName].(string)),
+			}
+		}
+
+		if v, ok := tfMap[names.AttrSchedule].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.Schedule = &awstypes.Schedule{
+				Expression: aws.String(v[0].(map[string]interface{})[names.AttrExpression].(string)),
+			}
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandVersioningConfiguration(tfList []interface{}) *awstypes.VersioningConfiguration {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	apiObject := &awstypes.VersioningConfiguration{}
+
+	if v, ok := tfMap["max_versions"].(int); ok && v != 0 {
+		apiObject.MaxVersions = aws.Int32(int32(v))
+	}
+
+	if v, ok := tfMap["unlimited"].(bool); ok && v {
+		apiObject.Unlimited = v
+	}
+
+	return apiObject
+}
+
+func flattenDatasetActions(apiObjects []awstypes.DatasetAction) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		tfMap := map[string]interface{}{
+			names.AttrName: aws.ToString(apiObject.ActionName),
+		}
+
+		if v := apiObject.ContainerAction; v != nil {
+			tfMap["container_action"] = flattenContainerDatasetAction(v)
+		}
+
+		if v := apiObject.QueryAction; v != nil {
+			tfMap["query_action"] = flattenSQLQueryDatasetAction(v)
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func flattenContainerDatasetAction(apiObject *awstypes.ContainerDatasetAction) []interface{} {
+	tfMap := map[string]interface{}{
+		names.AttrExecutionRoleARN: aws.ToString(apiObject.ExecutionRoleArn),
+		"image":                    aws.ToString(apiObject.Image),
+	}
+
+	if v := apiObject.ResourceConfiguration; v != nil {
+		tfMap["resource_configuration"] = []interface{}{map[string]interface{}{
+			"compute_type":      string(v.ComputeType),
+			"volume_size_in_gb": aws.ToInt32(v.VolumeSizeInGB),
+		}}
+	}
+
+	var variables []interface{}
+
+	for _, apiObject := range apiObject.Variables {
+		variable := map[string]interface{}{
+			"double_value": aws.ToFloat64(apiObject.DoubleValue),
+			names.AttrName: aws.ToString(apiObject.Name),
+			"string_value": aws.ToString(apiObject.StringValue),
+		}
+
+		if v := apiObject.DatasetContentVersionValue; v != nil {
+			variable["dataset_content_version_value"] = []interface{}{map[string]interface{}{
+				"dataset_name": aws.ToString(v.DatasetName),
+			}}
+		}
+
+		if v := apiObject.OutputFileUriValue; v != nil {
+			variable["output_file_uri_value"] = []interface{}{map[string]interface{}{
+				"file_name": aws.ToString(v.FileName),
+			}}
+		}
+
+		variables = append(variables, variable)
+	}
+
+	tfMap["variable"] = variables
+
+	return []interface{}{tfMap}
+}
+
+func flattenSQLQueryDatasetAction(apiObject *awstypes.SqlQueryDatasetAction) []interface{} {
+	tfMap := map[string]interface{}{
+		"sql_query": aws.ToString(apiObject.SqlQuery),
+	}
+
+	var filters []interface{}
+
+	for _, apiObject := range apiObject.Filters {
+		filter := map[string]interface{}{}
+
+		if v := apiObject.DeltaTime; v != nil {
+			filter["delta_time"] = []interface{}{map[string]interface{}{
+				"offset_seconds":  aws.ToInt32(v.OffsetSeconds),
+				"time_expression": aws.ToString(v.TimeExpression),
+			}}
+		}
+
+		filters = append(filters, filter)
+	}
+
+	tfMap[names.AttrFilter] = filters
+
+	return []interface{}{tfMap}
+}
+
+func flattenDatasetContentDeliveryRules(apiObjects []awstypes.DatasetContentDeliveryRule) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		tfMap := map[string]interface{}{
+			"entry_name": aws.ToString(apiObject.EntryName),
+		}
+
+		if v := apiObject.Destination; v != nil {
+			destination := map[string]interface{}{}
+
+			if v := v.IotEventsDestinationConfiguration; v != nil {
+				destination["iot_events_destination_configuration"] = []interface{}{map[string]interface{}{
+					"input_name":      aws.ToString(v.InputName),
+					names.AttrRoleARN: aws.ToString(v.RoleArn),
+				}}
+			}
+
+			if v := v.S3DestinationConfiguration; v != nil {
+				s3Configuration := map[string]interface{}{
+					names.AttrBucket:  aws.ToString(v.Bucket),
+					names.AttrKey:     aws.ToString(v.Key),
+					names.AttrRoleARN: aws.ToString(v.RoleArn),
+				}
+
+				if v := v.GlueConfiguration; v != nil {
+					s3Configuration["glue_configuration"] = []interface{}{map[string]interface{}{
+						names.AttrDatabaseName: aws.ToString(v.DatabaseName),
+						names.AttrTableName:    aws.ToString(v.TableName),
+					}}
+				}
+
+				destination["s3_destination_configuration"] = []interface{}{s3Configuration}
+			}
+
+			tfMap[names.AttrDestination] = []interface{}{destination}
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func flattenLateDataRules(apiObjects []awstypes.LateDataRule) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		tfMap := map[string]interface{}{
+			"rule_name": aws.ToString(apiObject.RuleName),
+		}
+
+		if v := apiObject.RuleConfiguration; v != nil {
+			ruleConfiguration := map[string]interface{}{}
+
+			if v := v.DeltaTimeSessionWindowConfiguration; v != nil {
+				ruleConfiguration["delta_time_session_window_configuration"] = []interface{}{map[string]interface{}{
+					"timeout_in_minutes": aws.ToInt32(v.TimeoutInMinutes),
+				}}
+			}
+
+			tfMap["rule_configuration"] = []interface{}{ruleConfiguration}
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func flattenDatasetTriggers(apiObjects []awstypes.DatasetTrigger) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		tfMap := map[string]interface{}{}
+
+		if v := apiObject.Dataset; v != nil {
+			tfMap["dataset"] = []interface{}{map[string]interface{}{
+				names.AttrName: aws.ToString(v.Name),
+			}}
+		}
+
+		if v := apiObject.Schedule; v != nil {
+			tfMap[names.AttrSchedule] = []interface{}{map[string]interface{}{
+				names.AttrExpression: aws.ToString(v.Expression),
+			}}
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func flattenVersioningConfiguration(apiObject *awstypes.VersioningConfiguration) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"max_versions": aws.ToInt32(apiObject.MaxVersions),
+		"unlimited":    apiObject.Unlimited,
+	}
+
+	return []interface{}{tfMap}
+}
@@ -0,0 +1,541 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iotanalytics
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/iotanalytics"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/iotanalytics/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_iotanalytics_datastore", name="Datastore")
+// @Tags(identifierAttribute="arn")
+func resourceDatastore() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceDatastoreCreate,
+		ReadWithoutTimeout:   resourceDatastoreRead,
+		UpdateWithoutTimeout: resourceDatastoreUpdate,
+		DeleteWithoutTimeout: resourceDatastoreDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"datastore_partitions": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"partition": {
+							Type:     schema.TypeList,
+							Required: true,
+							ForceNew: true,
+							MinItems: 1,
+							MaxItems: 25,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"attribute_partition": {
+										Type:     schema.TypeList,
+										Optional: true,
+										ForceNew: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"attribute_name": {
+													Type:     schema.TypeString,
+													Required: true,
+													ForceNew: true,
+												},
+											},
+										},
+									},
+									"timestamp_partition": {
+										Type:     schema.TypeList,
+										Optional: true,
+										ForceNew: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"attribute_name": {
+													Type:     schema.TypeString,
+													Required: true,
+													ForceNew: true,
+												},
+												"timestamp_format": {
+													Type:     schema.TypeString,
+													Optional: true,
+													ForceNew: true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"file_format_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"json_configuration": {
+							Type:          schema.TypeList,
+							Optional:      true,
+							MaxItems:      1,
+							ConflictsWith: []string{"file_format_configuration.0.parquet_configuration"},
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{},
+							},
+						},
+						"parquet_configuration": {
+							Type:          schema.TypeList,
+							Optional:      true,
+							MaxItems:      1,
+							ConflictsWith: []string{"file_format_configuration.0.json_configuration"},
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"schema_definition": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"column": {
+													Type:     schema.TypeList,
+													Required: true,
+													MinItems: 1,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															names.AttrName: {
+																Type:         schema.TypeString,
+																Required:     true,
+																ValidateFunc: validation.StringLenBetween(1, 255),
+															},
+															names.AttrType: {
+																Type:         schema.TypeString,
+																Required:     true,
+																ValidateFunc: validation.StringLenBetween(1, 131072),
+															},
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			names.AttrName: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validName,
+			},
+			"retention_period": retentionPeriodSchema(),
+			"storage": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"customer_managed_s3": customerManagedS3Schema(),
+						"service_managed_s3": {
+							Type:          schema.TypeList,
+							Optional:      true,
+							MaxItems:      1,
+							ConflictsWith: []string{"storage.0.customer_managed_s3"},
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{},
+							},
+						},
+					},
+				},
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceDatastoreCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IoTAnalyticsClient(ctx)
+
+	name := d.Get(names.AttrName).(string)
+	input := &iotanalytics.CreateDatastoreInput{
+		DatastoreName: aws.String(name),
+		Tags:          getTagsIn(ctx),
+	}
+
+	if v, ok := d.GetOk("datastore_partitions"); ok {
+		input.DatastorePartitions = expandDatastorePartitions(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("file_format_configuration"); ok {
+		input.FileFormatConfiguration = expandFileFormatConfiguration(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("retention_period"); ok {
+		input.RetentionPeriod = expandRetentionPeriod(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("storage"); ok {
+		input.DatastoreStorage = expandDatastoreStorage(v.([]interface{}))
+	}
+
+	_, err := conn.CreateDatastore(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating IoT Analytics Datastore (%s): %s", name, err)
+	}
+
+	d.SetId(name)
+
+	return append(diags, resourceDatastoreRead(ctx, d, meta)...)
+}
+
+func resourceDatastoreRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IoTAnalyticsClient(ctx)
+
+	datastore, err := findDatastoreByName(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] IoT Analytics Datastore (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading IoT Analytics Datastore (%s): %s", d.Id(), err)
+	}
+
+	d.Set(names.AttrARN, datastore.Arn)
+	if err := d.Set("datastore_partitions", flattenDatastorePartitions(datastore.DatastorePartitions)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting datastore_partitions: %s", err)
+	}
+	if err := d.Set("file_format_configuration", flattenFileFormatConfiguration(datastore.FileFormatConfiguration)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting file_format_configuration: %s", err)
+	}
+	d.Set(names.AttrName, datastore.Name)
+	if err := d.Set("retention_period", flattenRetentionPeriod(datastore.RetentionPeriod)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting retention_period: %s", err)
+	}
+	if err := d.Set("storage", flattenDatastoreStorage(datastore.Storage)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting storage: %s", err)
+	}
+
+	return diags
+}
+
+func resourceDatastoreUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IoTAnalyticsClient(ctx)
+
+	if d.HasChangesExcept(names.AttrTags, names.AttrTagsAll) {
+		input := &iotanalytics.UpdateDatastoreInput{
+			DatastoreName: aws.String(d.Id()),
+		}
+
+		if v, ok := d.GetOk("file_format_configuration"); ok {
+			input.FileFormatConfiguration = expandFileFormatConfiguration(v.([]interface{}))
+		}
+
+		if v, ok := d.GetOk("retention_period"); ok {
+			input.RetentionPeriod = expandRetentionPeriod(v.([]interface{}))
+		}
+
+		if v, ok := d.GetOk("storage"); ok {
+			input.DatastoreStorage = expandDatastoreStorage(v.([]interface{}))
+		}
+
+		_, err := conn.UpdateDatastore(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating IoT Analytics Datastore (%s): %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceDatastoreRead(ctx, d, meta)...)
+}
+
+func resourceDatastoreDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IoTAnalyticsClient(ctx)
+
+	log.Printf("[DEBUG] Deleting IoT Analytics Datastore: %s", d.Id())
+	_, err := conn.DeleteDatastore(ctx, &iotanalytics.DeleteDatastoreInput{
+		DatastoreName: aws.String(d.Id()),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting IoT Analytics Datastore (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func findDatastoreByName(ctx context.Context, conn *iotanalytics.Client, name string) (*awstypes.Datastore, error) {
+	input := &iotanalytics.DescribeDatastoreInput{
+		DatastoreName: aws.String(name),
+	}
+
+	output, err := conn.DescribeDatastore(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Datastore == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Datastore, nil
+}
+
+func expandDatastoreStorage(tfList []interface{}) awstypes.DatastoreStorage {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	if v, ok := tfMap["customer_managed_s3"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		apiObject := &awstypes.DatastoreStorageMemberCustomerManagedS3{
+			Value: awstypes.CustomerManagedDatastoreS3Storage{
+				Bucket:  aws.String(tfMap[names.AttrBucket].(string)),
+				RoleArn: aws.String(tfMap[names.AttrRoleARN].(string)),
+			},
+		}
+
+		if v, ok := tfMap["key_prefix"].(string); ok && v != "" {
+			apiObject.Value.KeyPrefix = aws.String(v)
+		}
+
+		return apiObject
+	}
+
+	if v, ok := tfMap["service_managed_s3"].([]interface{}); ok && len(v) > 0 {
+		return &awstypes.DatastoreStorageMemberServiceManagedS3{
+			Value: awstypes.ServiceManagedDatastoreS3Storage{},
+		}
+	}
+
+	return nil
+}
+
+func expandFileFormatConfiguration(tfList []interface{}) *awstypes.FileFormatConfiguration {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	apiObject := &awstypes.FileFormatConfiguration{}
+
+	if v, ok := tfMap["json_configuration"].([]interface{}); ok && len(v) > 0 {
+		apiObject.JsonConfiguration = &awstypes.JsonConfiguration{}
+	}
+
+	if v, ok := tfMap["parquet_configuration"].([]interface{}); ok && len(v) > 0 {
+		apiObject.ParquetConfiguration = &awstypes.ParquetConfiguration{}
+
+		if v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+
+			if v, ok := tfMap["schema_definition"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+				tfMap := v[0].(map[string]interface{})
+				schemaDefinition := &awstypes.SchemaDefinition{}
+
+				for _, tfMapRaw := range tfMap["column"].([]interface{}) {
+					tfMap, ok := tfMapRaw.(map[string]interface{})
+
+					if !ok {
+						continue
+					}
+
+					schemaDefinition.Columns = append(schemaDefinition.Columns, awstypes.Column{
+						Name: aws.String(tfMap[names.AttrName].(string)),
+						Type: aws.String(tfMap[names.AttrType].(string)),
+					})
+				}
+
+				apiObject.ParquetConfiguration.SchemaDefinition = schemaDefinition
+			}
+		}
+	}
+
+	return apiObject
+}
+
+func expandDatastorePartitions(tfList []interface{}) *awstypes.DatastorePartitions {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	apiObject := &awstypes.DatastorePartitions{}
+
+	for _, tfMapRaw := range tfMap["partition"].([]interface{}) {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		partition := awstypes.DatastorePartition{}
+
+		if v, ok := tfMap["attribute_partition"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			partition.AttributePartition = &awstypes.Partition{
+				AttributeName: aws.String(v[0].(map[string]interface{})["attribute_name"].(string)),
+			}
+		}
+
+		if v, ok := tfMap["timestamp_partition"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+			partition.TimestampPartition = &awstypes.TimestampPartition{
+				AttributeName: aws.String(tfMap["attribute_name"].(string)),
+			}
+
+			if v, ok := tfMap["timestamp_format"].(string); ok && v != "" {
+				partition.TimestampPartition.TimestampFormat = aws.String(v)
+			}
+		}
+
+		apiObject.Partitions = append(apiObject.Partitions, partition)
+	}
+
+	return apiObject
+}
+
+func flattenDatastoreStorage(apiObject awstypes.DatastoreStorage) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	switch v := apiObject.(type) {
+	case *awstypes.DatastoreStorageMemberCustomerManagedS3:
+		tfMap["customer_managed_s3"] = []interface{}{map[string]interface{}{
+			names.AttrBucket:  aws.ToString(v.Value.Bucket),
+			"key_prefix":      aws.ToString(v.Value.KeyPrefix),
+			names.AttrRoleARN: aws.ToString(v.Value.RoleArn),
+		}}
+	case *awstypes.DatastoreStorageMemberServiceManagedS3:
+		tfMap["service_managed_s3"] = []interface{}{map[string]interface{}{}}
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenFileFormatConfiguration(apiObject *awstypes.FileFormatConfiguration) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.JsonConfiguration; v != nil {
+		tfMap["json_configuration"] = []interface{}{map[string]interface{}{}}
+	}
+
+	if v := apiObject.ParquetConfiguration; v != nil {
+		parquetConfiguration := map[string]interface{}{}
+
+		if v := v.SchemaDefinition; v != nil {
+			var columns []interface{}
+
+			for _, apiObject := range v.Columns {
+				columns = append(columns, map[string]interface{}{
+					names.AttrName: aws.ToString(apiObject.Name),
+					names.AttrType: aws.ToString(apiObject.Type),
+				})
+			}
+
+			parquetConfiguration["schema_definition"] = []interface{}{map[string]interface{}{
+				"column": columns,
+			}}
+		}
+
+		tfMap["parquet_configuration"] = []interface{}{parquetConfiguration}
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenDatastorePartitions(apiObject *awstypes.DatastorePartitions) []interface{} {
+	if apiObject == nil || len(apiObject.Partitions) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObject.Partitions {
+		tfMap := map[string]interface{}{}
+
+		if v := apiObject.AttributePartition; v != nil {
+			tfMap["attribute_partition"] = []interface{}{map[string]interface{}{
+				"attribute_name": aws.ToString(v.AttributeName),
+			}}
+		}
+
+		if v := apiObject.TimestampPartition; v != nil {
+			tfMap["timestamp_partition"] = []interface{}{map[string]interface{}{
+				"attribute_name":   aws.ToString(v.AttributeName),
+				"timestamp_format": aws.ToString(v.TimestampFormat),
+			}}
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return []interface{}{map[string]interface{}{
+		"partition": tfList,
+	}}
+}
@@ -0,0 +1,311 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iotanalytics_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/iotanalytics/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfiotanalytics "github.com/hashicorp/terraform-provider-aws/internal/service/iotanalytics"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccIoTAnalyticsDatastore_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.Datastore
+	rName := testAccName()
+	resourceName := "aws_iotanalytics_datastore.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, names.IoTAnalyticsEndpointID) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.IoTAnalyticsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDatastoreDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDatastoreConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDatastoreExists(ctx, resourceName, &v),
+					acctest.MatchResourceAttrRegionalARN(resourceName, names.AttrARN, "iotanalytics", regexache.MustCompile(fmt.Sprintf("datastore/%s$", rName))),
+					resource.TestCheckResourceAttr(resourceName, "datastore_partitions.#", acctest.Ct0),
+					resource.TestCheckResourceAttr(resourceName, "file_format_configuration.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "file_format_configuration.0.json_configuration.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+					resource.TestCheckResourceAttr(resourceName, "retention_period.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "retention_period.0.unlimited", acctest.CtTrue),
+					resource.TestCheckResourceAttr(resourceName, "storage.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "storage.0.service_managed_s3.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct0),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccIoTAnalyticsDatastore_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.Datastore
+	rName := testAccName()
+	resourceName := "aws_iotanalytics_datastore.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, names.IoTAnalyticsEndpointID) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.IoTAnalyticsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDatastoreDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDatastoreConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDatastoreExists(ctx, resourceName, &v),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfiotanalytics.ResourceDatastore(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccIoTAnalyticsDatastore_retentionPeriod(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.Datastore
+	rName := testAccName()
+	resourceName := "aws_iotanalytics_datastore.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, names.IoTAnalyticsEndpointID) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.IoTAnalyticsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDatastoreDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDatastoreConfig_retentionPeriod(rName, 30),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDatastoreExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "retention_period.0.number_of_days", "30"),
+					resource.TestCheckResourceAttr(resourceName, "retention_period.0.unlimited", acctest.CtFalse),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccDatastoreConfig_retentionPeriod(rName, 60),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDatastoreExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "retention_period.0.number_of_days", "60"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIoTAnalyticsDatastore_customerManagedS3(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.Datastore
+	rName := testAccName()
+	resourceName := "aws_iotanalytics_datastore.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, names.IoTAnalyticsEndpointID) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.IoTAnalyticsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDatastoreDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDatastoreConfig_customerManagedS3(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDatastoreExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "storage.0.customer_managed_s3.#", acctest.Ct1),
+					resource.TestCheckResourceAttrPair(resourceName, "storage.0.customer_managed_s3.0.bucket", "aws_s3_bucket.test", names.AttrBucket),
+					resource.TestCheckResourceAttr(resourceName, "storage.0.customer_managed_s3.0.key_prefix", "datastore/"),
+					resource.TestCheckResourceAttrPair(resourceName, "storage.0.customer_managed_s3.0.role_arn", "aws_iam_role.test", names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "storage.0.service_managed_s3.#", acctest.Ct0),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccIoTAnalyticsDatastore_parquet(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.Datastore
+	rName := testAccName()
+	resourceName := "aws_iotanalytics_datastore.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, names.IoTAnalyticsEndpointID) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.IoTAnalyticsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDatastoreDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDatastoreConfig_parquet(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDatastoreExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "datastore_partitions.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "datastore_partitions.0.partition.#", acctest.Ct2),
+					resource.TestCheckResourceAttr(resourceName, "datastore_partitions.0.partition.0.attribute_partition.0.attribute_name", "device_id"),
+					resource.TestCheckResourceAttr(resourceName, "datastore_partitions.0.partition.1.timestamp_partition.0.attribute_name", "event_time"),
+					resource.TestCheckResourceAttr(resourceName, "file_format_configuration.0.json_configuration.#", acctest.Ct0),
+					resource.TestCheckResourceAttr(resourceName, "file_format_configuration.0.parquet_configuration.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "file_format_configuration.0.parquet_configuration.0.schema_definition.0.column.#", acctest.Ct3),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckDatastoreDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).IoTAnalyticsClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_iotanalytics_datastore" {
+				continue
+			}
+
+			_, err := tfiotanalytics.FindDatastoreByName(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("IoT Analytics Datastore %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckDatastoreExists(ctx context.Context, n string, v *awstypes.Datastore) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).IoTAnalyticsClient(ctx)
+
+		output, err := tfiotanalytics.FindDatastoreByName(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccDatastoreConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_iotanalytics_datastore" "test" {
+  name = %[1]q
+}
+`, rName)
+}
+
+func testAccDatastoreConfig_retentionPeriod(rName string, days int) string {
+	return fmt.Sprintf(`
+resource "aws_iotanalytics_datastore" "test" {
+  name = %[1]q
+
+  retention_period {
+    number_of_days = %[2]d
+  }
+}
+`, rName, days)
+}
+
+func testAccDatastoreConfig_customerManagedS3(rName string) string {
+	return acctest.ConfigCompose(testAccConfig_storageRole(rName), fmt.Sprintf(`
+resource "aws_iotanalytics_datastore" "test" {
+  name = %[1]q
+
+  storage {
+    customer_managed_s3 {
+      bucket     = aws_s3_bucket.test.bucket
+      key_prefix = "datastore/"
+      role_arn   = aws_iam_role.test.arn
+    }
+  }
+
+  depends_on = [aws_iam_role_policy.test]
+}
+`, rName))
+}
+
+func testAccDatastoreConfig_parquet(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_iotanalytics_datastore" "test" {
+  name = %[1]q
+
+  file_format_configuration {
+    parquet_configuration {
+      schema_definition {
+        column {
+          name = "device_id"
+          type = "string"
+        }
+
+        column {
+          name = "event_time"
+          type = "timestamp"
+        }
+
+        column {
+          name = "temperature"
+          type = "double"
+        }
+      }
+    }
+  }
+
+  datastore_partitions {
+    partition {
+      attribute_partition {
+        attribute_name = "device_id"
+      }
+    }
+
+    partition {
+      timestamp_partition {
+        attribute_name = "event_time"
+      }
+    }
+  }
+}
+`, rName)
+}
@@ -0,0 +1,599 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iotanalytics
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/iotanalytics"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/iotanalytics/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_iotanalytics_pipeline", name="Pipeline")
+// @Tags(identifierAttribute="arn")
+func resourcePipeline() *schema.Resource {
+	roleEnrichSchema := func() *schema.Schema {
+		return &schema.Schema{
+			Type:     schema.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"attribute": {
+						Type:         schema.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringLenBetween(1, 256),
+					},
+					names.AttrRoleARN: {
+						Type:         schema.TypeString,
+						Required:     true,
+						ValidateFunc: verify.ValidARN,
+					},
+					"thing_name": {
+						Type:         schema.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringLenBetween(1, 256),
+					},
+				},
+			},
+		}
+	}
+
+	return &schema.Resource{
+		CreateWithoutTimeout: resourcePipelineCreate,
+		ReadWithoutTimeout:   resourcePipelineRead,
+		UpdateWithoutTimeout: resourcePipelineUpdate,
+		DeleteWithoutTimeout: resourcePipelineDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"activity": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 2,
+				MaxItems: 25,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"add_attributes": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									names.AttrAttributes: {
+										Type:     schema.TypeMap,
+										Required: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+								},
+							},
+						},
+						"channel": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"channel_name": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validName,
+									},
+								},
+							},
+						},
+						"datastore": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"datastore_name": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validName,
+									},
+								},
+							},
+						},
+						"device_registry_enrich": roleEnrichSchema(),
+						"device_shadow_enrich":   roleEnrichSchema(),
+						"filter": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									names.AttrFilter: {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringLenBetween(1, 256),
+									},
+								},
+							},
+						},
+						"lambda": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"batch_size": {
+										Type:         schema.TypeInt,
+										Required:     true,
+										ValidateFunc: validation.IntBetween(1, 1000),
+									},
+									"lambda_name": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringLenBetween(1, 64),
+									},
+								},
+							},
+						},
+						"math": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"attribute": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringLenBetween(1, 256),
+									},
+									"math": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringLenBetween(1, 256),
+									},
+								},
+							},
+						},
+						names.AttrName: {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 128),
+						},
+						"remove_attributes": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									names.AttrAttributes: {
+										Type:     schema.TypeList,
+										Required: true,
+										MinItems: 1,
+										MaxItems: 50,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+								},
+							},
+						},
+						"select_attributes": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									names.AttrAttributes: {
+										Type:     schema.TypeList,
+										Required: true,
+										MinItems: 1,
+										MaxItems: 50,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrName: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validName,
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourcePipelineCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IoTAnalyticsClient(ctx)
+
+	name := d.Get(names.AttrName).(string)
+	input := &iotanalytics.CreatePipelineInput{
+		PipelineActivities: expandPipelineActivities(d.Get("activity").([]interface{})),
+		PipelineName:       aws.String(name),
+		Tags:               getTagsIn(ctx),
+	}
+
+	_, err := conn.CreatePipeline(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating IoT Analytics Pipeline (%s): %s", name, err)
+	}
+
+	d.SetId(name)
+
+	return append(diags, resourcePipelineRead(ctx, d, meta)...)
+}
+
+func resourcePipelineRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IoTAnalyticsClient(ctx)
+
+	pipeline, err := findPipelineByName(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] IoT Analytics Pipeline (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading IoT Analytics Pipeline (%s): %s", d.Id(), err)
+	}
+
+	if err := d.Set("activity", flattenPipelineActivities(pipeline.Activities)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting activity: %s", err)
+	}
+	d.Set(names.AttrARN, pipeline.Arn)
+	d.Set(names.AttrName, pipeline.Name)
+
+	return diags
+}
+
+func resourcePipelineUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IoTAnalyticsClient(ctx)
+
+	if d.HasChangesExcept(names.AttrTags, names.AttrTagsAll) {
+		input := &iotanalytics.UpdatePipelineInput{
+			PipelineActivities: expandPipelineActivities(d.Get("activity").([]interface{})),
+			PipelineName:       aws.String(d.Id()),
+		}
+
+		_, err := conn.UpdatePipeline(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating IoT Analytics Pipeline (%s): %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourcePipelineRead(ctx, d, meta)...)
+}
+
+func resourcePipelineDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IoTAnalyticsClient(ctx)
+
+	log.Printf("[DEBUG] Deleting IoT Analytics Pipeline: %s", d.Id())
+	_, err := conn.DeletePipeline(ctx, &iotanalytics.DeletePipelineInput{
+		PipelineName: aws.String(d.Id()),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting IoT Analytics Pipeline (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func findPipelineByName(ctx context.Context, conn *iotanalytics.Client, name string) (*awstypes.Pipeline, error) {
+	input := &iotanalytics.DescribePipelineInput{
+		PipelineName: aws.String(name),
+	}
+
+	output, err := conn.DescribePipeline(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Pipeline == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Pipeline, nil
+}
+
+// expandPipelineActivities expands the ordered list of activities, chaining
+// each activity to the one that follows it in configuration.
+func expandPipelineActivities(tfList []interface{}) []awstypes.PipelineActivity {
+	var activityNames []string
+
+	for _, tfMapRaw := range tfList {
+		if tfMap, ok := tfMapRaw.(map[string]interface{}); ok {
+			activityNames = append(activityNames, tfMap[names.AttrName].(string))
+		}
+	}
+
+	var apiObjects []awstypes.PipelineActivity
+
+	for i, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		var next *string
+		if i+1 < len(activityNames) {
+			next = aws.String(activityNames[i+1])
+		}
+
+		apiObjects = append(apiObjects, expandPipelineActivity(tfMap, next))
+	}
+
+	return apiObjects
+}
+
+func expandPipelineActivity(tfMap map[string]interface{}, next *string) awstypes.PipelineActivity {
+	apiObject := awstypes.PipelineActivity{}
+	name := aws.String(tfMap[names.AttrName].(string))
+
+	if v, ok := tfMap["add_attributes"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.AddAttributes = &awstypes.AddAttributesActivity{
+			Attributes: flex.ExpandStringValueMap(v[0].(map[string]interface{})[names.AttrAttributes].(map[string]interface{})),
+			Name:       name,
+			Next:       next,
+		}
+	}
+
+	if v, ok := tfMap["channel"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.Channel = &awstypes.ChannelActivity{
+			ChannelName: aws.String(v[0].(map[string]interface{})["channel_name"].(string)),
+			Name:        name,
+			Next:        next,
+		}
+	}
+
+	if v, ok := tfMap["datastore"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.Datastore = &awstypes.DatastoreActivity{
+			DatastoreName: aws.String(v[0].(map[string]interface{})["datastore_name"].(string)),
+			Name:          name,
+		}
+	}
+
+	if v, ok := tfMap["device_registry_enrich"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		apiObject.DeviceRegistryEnrich = &awstypes.DeviceRegistryEnrichActivity{
+			Attribute: aws.String(tfMap["attribute"].(string)),
+			Name:      name,
+			Next:      next,
+			RoleArn:   aws.String(tfMap[names.AttrRoleARN].(string)),
+			ThingName: aws.String(tfMap["thing_name"].(string)),
+		}
+	}
+
+	if v, ok := tfMap["device_shadow_enrich"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		apiObject.DeviceShadowEnrich = &awstypes.DeviceShadowEnrichActivity{
+			Attribute: aws.String(tfMap["attribute"].(string)),
+			Name:      name,
+			Next:      next,
+			RoleArn:   aws.String(tfMap[names.AttrRoleARN].(string)),
+			ThingName: aws.String(tfMap["thing_name"].(string)),
+		}
+	}
+
+	if v, ok := tfMap["filter"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.Filter = &awstypes.FilterActivity{
+			Filter: aws.String(v[0].(map[string]interface{})[names.AttrFilter].(string)),
+			Name:   name,
+			Next:   next,
+		}
+	}
+
+	if v, ok := tfMap["lambda"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		apiObject.Lambda = &awstypes.LambdaActivity{
+			BatchSize:  aws.Int32(int32(tfMap["batch_size"].(int))),
+			LambdaName: aws.String(tfMap["lambda_name"].(string)),
+			Name:       name,
+			Next:       next,
+		}
+	}
+
+	if v, ok := tfMap["math"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		apiObject.Math = &awstypes.MathActivity{
+			Attribute: aws.String(tfMap["attribute"].(string)),
+			Math:      aws.String(tfMap["math"].(string)),
+			Name:      name,
+			Next:      next,
+		}
+	}
+
+	if v, ok := tfMap["remove_attributes"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.RemoveAttributes = &awstypes.RemoveAttributesActivity{
+			Attributes: flex.ExpandStringValueList(v[0].(map[string]interface{})[names.AttrAttributes].([]interface{})),
+			Name:       name,
+			Next:       next,
+		}
+	}
+
+	if v, ok := tfMap["select_attributes"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.SelectAttributes = &awstypes.SelectAttributesActivity{
+			Attributes: flex.ExpandStringValueList(v[0].(map[string]interface{})[names.AttrAttributes].([]interface{})),
+			Name:       name,
+			Next:       next,
+		}
+	}
+
+	return apiObject
+}
+
+// flattenPipelineActivities flattens activities in pipeline order, starting
+// from the channel activity and following each activity's next pointer.
+func flattenPipelineActivities(apiObjects []awstypes.PipelineActivity) []interface{} {
+	type activity struct {
+		tfMap map[string]interface{}
+		next  string
+	}
+
+	var (
+		start     string
+		order     []string
+		byName    = make(map[string]activity)
+		tfList    []interface{}
+		flattened = make(map[string]bool)
+	)
+
+	for _, apiObject := range apiObjects {
+		name, next, tfMap := flattenPipelineActivity(apiObject)
+		if apiObject.Channel != nil && start == "" {
+			start = name
+		}
+
+		order = append(order, name)
+		byName[name] = activity{tfMap: tfMap, next: next}
+	}
+
+	for name := start; name != ""; {
+		v, ok := byName[name]
+		if !ok || flattened[name] {
+			break
+		}
+
+		tfList = append(tfList, v.tfMap)
+		flattened[name] = true
+		name = v.next
+	}
+
+	// Append anything not reachable from the channel activity in API order.
+	for _, name := range order {
+		if !flattened[name] {
+			tfList = append(tfList, byName[name].tfMap)
+			flattened[name] = true
+		}
+	}
+
+	return tfList
+}
+
+func flattenPipelineActivity(apiObject awstypes.PipelineActivity) (string, string, map[string]interface{}) {
+	var name, next *string
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.AddAttributes; v != nil {
+		name, next = v.Name, v.Next
+		tfMap["add_attributes"] = []interface{}{map[string]interface{}{
+			names.AttrAttributes: flex.FlattenStringValueMap(v.Attributes),
+		}}
+	}
+
+	if v := apiObject.Channel; v != nil {
+		name, next = v.Name, v.Next
+		tfMap["channel"] = []interface{}{map[string]interface{}{
+			"channel_name": aws.ToString(v.ChannelName),
+		}}
+	}
+
+	if v := apiObject.Datastore; v != nil {
+		name = v.Name
+		tfMap["datastore"] = []interface{}{map[string]interface{}{
+			"datastore_name": aws.ToString(v.DatastoreName),
+		}}
+	}
+
+	if v := apiObject.DeviceRegistryEnrich; v != nil {
+		name, next = v.Name, v.Next
+		tfMap["device_registry_enrich"] = []interface{}{map[string]interface{}{
+			"attribute":       aws.ToString(v.Attribute),
+			names.AttrRoleARN: aws.ToString(v.RoleArn),
+			"thing_name":      aws.ToString(v.ThingName),
+		}}
+	}
+
+	if v := apiObject.DeviceShadowEnrich; v != nil {
+		name, next = v.Name, v.Next
+		tfMap["device_shadow_enrich"] = []interface{}{map[string]interface{}{
+			"attribute":       aws.ToString(v.Attribute),
+			names.AttrRoleARN: aws.ToString(v.RoleArn),
+			"thing_name":      aws.ToString(v.ThingName),
+		}}
+	}
+
+	if v := apiObject.Filter; v != nil {
+		name, next = v.Name, v.Next
+		tfMap["filter"] = []interface{}{map[string]interface{}{
+			names.AttrFilter: aws.ToString(v.Filter),
+		}}
+	}
+
+	if v := apiObject.Lambda; v != nil {
+		name, next = v.Name, v.Next
+		tfMap["lambda"] = []interface{}{map[string]interface{}{
+			"batch_size":  aws.ToInt32(v.BatchSize),
+			"lambda_name": aws.ToString(v.LambdaName),
+		}}
+	}
+
+	if v := apiObject.Math; v != nil {
+		name, next = v.Name, v.Next
+		tfMap["math"] = []interface{}{map[string]interface{}{
+			"attribute": aws.ToString(v.Attribute),
+			"math":      aws.ToString(v.Math),
+		}}
+	}
+
+	if v := apiObject.RemoveAttributes; v != nil {
+		name, next = v.Name, v.Next
+		tfMap["remove_attributes"] = []interface{}{map[string]interface{}{
+			names.AttrAttributes: flex.FlattenStringValueList(v.Attributes),
+		}}
+	}
+
+	if v := apiObject.SelectAttributes; v != nil {
+		name, next = v.Name, v.Next
+		tfMap["select_attributes"] = []interface{}{map[string]interface{}{
+			names.AttrAttributes: flex.FlattenStringValueList(v.Attributes),
+		}}
+	}
+
+	tfMap[names.AttrName] = aws.ToString(name)
+
+	return aws.ToString(name), aws.ToString(next), tfMap
+}
@@ -4,17 +4,17 @@ package iotanalytics_test
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"maps"
-	"net/url"
 	"os"
 	"path/filepath"
 	"reflect"
 	"strings"
 	"testing"
 
-	"github.com/aws/aws-sdk-go/aws/endpoints"
-	iotanalytics_sdkv1 "github.com/aws/aws-sdk-go/service/iotanalytics"
+	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
+	iotanalytics_sdkv2 "github.com/aws/aws-sdk-go-v2/service/iotanalytics"
 	"github.com/aws/smithy-go/middleware"
 	smithyhttp "github.com/aws/smithy-go/transport/http"
 	"github.com/google/go-cmp/cmp"
@@ -213,32 +213,42 @@ func TestEndpointConfiguration(t *testing.T) { //nolint:paralleltest // uses t.S
 }
 
 func defaultEndpoint(region string) string {
-	r := endpoints.DefaultResolver()
+	r := iotanalytics_sdkv2.NewDefaultEndpointResolverV2()
 
-	ep, err := r.EndpointFor(iotanalytics_sdkv1.EndpointsID, region)
+	ep, err := r.ResolveEndpoint(context.Background(), iotanalytics_sdkv2.EndpointParameters{
+		Region: aws_sdkv2.String(region),
+	})
 	if err != nil {
 		return err.Error()
 	}
 
-	url, _ := url.Parse(ep.URL)
-
-	if url.Path == "" {
-		url.Path = "/"
+	if ep.URI.Path == "" {
+		ep.URI.Path = "/"
 	}
 
-	return url.String()
+	return ep.URI.String()
 }
 
 func callService(ctx context.Context, t *testing.T, meta *conns.AWSClient) string {
 	t.Helper()
 
-	client := meta.IoTAnalyticsConn(ctx)
-
-	req, _ := client.ListChannelsRequest(&iotanalytics_sdkv1.ListChannelsInput{})
+	var endpoint string
 
-	req.HTTPRequest.URL.Path = "/"
+	client := meta.IoTAnalyticsClient(ctx)
 
-	endpoint := req.HTTPRequest.URL.String()
+	_, err := client.ListChannels(ctx, &iotanalytics_sdkv2.ListChannelsInput{},
+		func(opts *iotanalytics_sdkv2.Options) {
+			opts.APIOptions = append(opts.APIOptions,
+				addRetrieveEndpointURLMiddleware(t, &endpoint),
+				addCancelRequestMiddleware(),
+			)
+		},
+	)
+	if err == nil {
+		t.Fatal("Expected an error, got none")
+	} else if !errors.Is(err, errCancelOperation) {
+		t.Fatalf("Unexpected error: %s", err)
+	}
 
 	return endpoint
 }
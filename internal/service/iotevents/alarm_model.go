@@ -0,0 +1,870 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iotevents
+
+import (
+	"context"
+	"errors"
+	"log"
+	"math"
+	"time"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/iotevents"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/iotevents/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_iotevents_alarm_model", name="Alarm Model")
+// @Tags(identifierAttribute="arn")
+func resourceAlarmModel() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceAlarmModelCreate,
+		ReadWithoutTimeout:   resourceAlarmModelRead,
+		UpdateWithoutTimeout: resourceAlarmModelUpdate,
+		DeleteWithoutTimeout: resourceAlarmModelDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"alarm_capabilities": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"acknowledge_flow": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Computed: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									names.AttrEnabled: {
+										Type:     schema.TypeBool,
+										Required: true,
+									},
+								},
+							},
+						},
+						"initialization_configuration": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Computed: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"disabled_on_initialization": {
+										Type:     schema.TypeBool,
+										Required: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"alarm_event_actions": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"alarm_action": {
+							Type:     schema.TypeList,
+							Required: true,
+							MinItems: 1,
+							MaxItems: 10,
+							Elem: &schema.Resource{
+								Schema: targetActionSchemaMap(),
+							},
+						},
+					},
+				},
+			},
+			"alarm_notification": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"notification_action": {
+							Type:     schema.TypeList,
+							Required: true,
+							MinItems: 1,
+							MaxItems: 10,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									names.AttrAction: {
+										Type:     schema.TypeList,
+										Required: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"lambda_action": {
+													Type:     schema.TypeList,
+													Required: true,
+													MaxItems: 1,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															names.AttrFunctionARN: {
+																Type:         schema.TypeString,
+																Required:     true,
+																ValidateFunc: verify.ValidARN,
+															},
+															"payload": payloadSchema(),
+														},
+													},
+												},
+											},
+										},
+									},
+									"email_configuration": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 10,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"content": {
+													Type:     schema.TypeList,
+													Optional: true,
+													MaxItems: 1,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"additional_message": {
+																Type:     schema.TypeString,
+																Optional: true,
+															},
+															"subject": {
+																Type:     schema.TypeString,
+																Optional: true,
+															},
+														},
+													},
+												},
+												"from": {
+													Type:     schema.TypeString,
+													Required: true,
+												},
+												"recipients": {
+													Type:     schema.TypeList,
+													Required: true,
+													MaxItems: 1,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"to": recipientDetailSchema(),
+														},
+													},
+												},
+											},
+										},
+									},
+									"sms_configuration": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 10,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"additional_message": {
+													Type:     schema.TypeString,
+													Optional: true,
+												},
+												"recipients": recipientDetailSchema(),
+												"sender_id": {
+													Type:     schema.TypeString,
+													Optional: true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"alarm_rule": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"simple_rule": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"comparison_operator": {
+										Type:             schema.TypeString,
+										Required:         true,
+										ValidateDiagFunc: enum.Validate[awstypes.ComparisonOperator](),
+									},
+									"input_property": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringLenBetween(1, 512),
+									},
+									"threshold": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringLenBetween(1, 512),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrDescription: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 128),
+			},
+			names.AttrKey: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 128),
+			},
+			names.AttrName: {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 128),
+					validation.StringMatch(regexache.MustCompile(`^[0-9A-Za-z_-]+$`), "must contain only alphanumeric characters, hyphens and underscores"),
+				),
+			},
+			names.AttrRoleARN: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"severity": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(0, math.MaxInt32),
+			},
+			names.AttrStatus: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			names.AttrVersion: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func recipientDetailSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Required: true,
+		MinItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"sso_identity": {
+					Type:     schema.TypeList,
+					Required: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"identity_store_id": {
+								Type:     schema.TypeString,
+								Required: true,
+							},
+							"user_id": {
+								Type:     schema.TypeString,
+								Optional: true,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceAlarmModelCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IoTEventsClient(ctx)
+
+	name := d.Get(names.AttrName).(string)
+	input := &iotevents.CreateAlarmModelInput{
+		AlarmModelName: aws.String(name),
+		AlarmRule:      expandAlarmRule(d.Get("alarm_rule").([]interface{})),
+		RoleArn:        aws.String(d.Get(names.AttrRoleARN).(string)),
+		Tags:           getTagsIn(ctx),
+	}
+
+	if v, ok := d.GetOk("alarm_capabilities"); ok {
+		input.AlarmCapabilities = expandAlarmCapabilities(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("alarm_event_actions"); ok {
+		input.AlarmEventActions = expandAlarmEventActions(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("alarm_notification"); ok {
+		input.AlarmNotification = expandAlarmNotification(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk(names.AttrDescription); ok {
+		input.AlarmModelDescription = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk(names.AttrKey); ok {
+		input.Key = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("severity"); ok {
+		input.Severity = aws.Int32(int32(v.(int)))
+	}
+
+	_, err := conn.CreateAlarmModel(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating IoT Events Alarm Model (%s): %s", name, err)
+	}
+
+	d.SetId(name)
+
+	if _, err := waitAlarmModelActive(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for IoT Events Alarm Model (%s) create: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceAlarmModelRead(ctx, d, meta)...)
+}
+
+func resourceAlarmModelRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IoTEventsClient(ctx)
+
+	output, err := findAlarmModelByName(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] IoT Events Alarm Model (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading IoT Events Alarm Model (%s): %s", d.Id(), err)
+	}
+
+	if err := d.Set("alarm_capabilities", flattenAlarmCapabilities(output.AlarmCapabilities)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting alarm_capabilities: %s", err)
+	}
+	if err := d.Set("alarm_event_actions", flattenAlarmEventActions(output.AlarmEventActions)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting alarm_event_actions: %s", err)
+	}
+	if err := d.Set("alarm_notification", flattenAlarmNotification(output.AlarmNotification)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting alarm_notification: %s", err)
+	}
+	if err := d.Set("alarm_rule", flattenAlarmRule(output.AlarmRule)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting alarm_rule: %s", err)
+	}
+	d.Set(names.AttrARN, output.AlarmModelArn)
+	d.Set(names.AttrDescription, output.AlarmModelDescription)
+	d.Set(names.AttrKey, output.Key)
+	d.Set(names.AttrName, output.AlarmModelName)
+	d.Set(names.AttrRoleARN, output.RoleArn)
+	d.Set("severity", output.Severity)
+	d.Set(names.AttrStatus, output.Status)
+	d.Set(names.AttrVersion, output.AlarmModelVersion)
+
+	return diags
+}
+
+func resourceAlarmModelUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IoTEventsClient(ctx)
+
+	if d.HasChangesExcept(names.AttrTags, names.AttrTagsAll) {
+		input := &iotevents.UpdateAlarmModelInput{
+			AlarmModelDescription: aws.String(d.Get(names.AttrDescription).(string)),
+			AlarmModelName:        aws.String(d.Id()),
+			AlarmRule:             expandAlarmRule(d.Get("alarm_rule").([]interface{})),
+			RoleArn:               aws.String(d.Get(names.AttrRoleARN).(string)),
+		}
+
+		if v, ok := d.GetOk("alarm_capabilities"); ok {
+			input.AlarmCapabilities = expandAlarmCapabilities(v.([]interface{}))
+		}
+
+		if v, ok := d.GetOk("alarm_event_actions"); ok {
+			input.AlarmEventActions = expandAlarmEventActions(v.([]interface{}))
+		}
+
+		if v, ok := d.GetOk("alarm_notification"); ok {
+			input.AlarmNotification = expandAlarmNotification(v.([]interface{}))
+		}
+
+		if v, ok := d.GetOk("severity"); ok {
+			input.Severity = aws.Int32(int32(v.(int)))
+		}
+
+		_, err := conn.UpdateAlarmModel(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating IoT Events Alarm Model (%s): %s", d.Id(), err)
+		}
+
+		if _, err := waitAlarmModelActive(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for IoT Events Alarm Model (%s) update: %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceAlarmModelRead(ctx, d, meta)...)
+}
+
+func resourceAlarmModelDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IoTEventsClient(ctx)
+
+	log.Printf("[DEBUG] Deleting IoT Events Alarm Model: %s", d.Id())
+	_, err := conn.DeleteAlarmModel(ctx, &iotevents.DeleteAlarmModelInput{
+		AlarmModelName: aws.String(d.Id()),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting IoT Events Alarm Model (%s): %s", d.Id(), err)
+	}
+
+	_, err = tfresource.RetryUntilNotFound(ctx, d.Timeout(schema.TimeoutDelete), func() (interface{}, error) {
+		return findAlarmModelByName(ctx, conn, d.Id())
+	})
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for IoT Events Alarm Model (%s) delete: %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func findAlarmModelByName(ctx context.Context, conn *iotevents.Client, name string) (*iotevents.DescribeAlarmModelOutput, error) {
+	input := &iotevents.DescribeAlarmModelInput{
+		AlarmModelName: aws.String(name),
+	}
+
+	output, err := conn.DescribeAlarmModel(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func statusAlarmModel(ctx context.Context, conn *iotevents.Client, name string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findAlarmModelByName(ctx, conn, name)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.Status), nil
+	}
+}
+
+func waitAlarmModelActive(ctx context.Context, conn *iotevents.Client, name string, timeout time.Duration) (*iotevents.DescribeAlarmModelOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.AlarmModelVersionStatusActivating),
+		Target:  enum.Slice(awstypes.AlarmModelVersionStatusActive),
+		Refresh: statusAlarmModel(ctx, conn, name),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*iotevents.DescribeAlarmModelOutput); ok {
+		tfresource.SetLastError(err, errors.New(aws.ToString(output.StatusMessage)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func expandAlarmRule(tfList []interface{}) *awstypes.AlarmRule {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	apiObject := &awstypes.AlarmRule{}
+
+	if v, ok := tfMap["simple_rule"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		apiObject.SimpleRule = &awstypes.SimpleRule{
+			ComparisonOperator: awstypes.ComparisonOperator(tfMap["comparison_operator"].(string)),
+			InputProperty:      aws.String(tfMap["input_property"].(string)),
+			Threshold:          aws.String(tfMap["threshold"].(string)),
+		}
+	}
+
+	return apiObject
+}
+
+func expandAlarmCapabilities(tfList []interface{}) *awstypes.AlarmCapabilities {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	apiObject := &awstypes.AlarmCapabilities{}
+
+	if v, ok := tfMap["acknowledge_flow"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.AcknowledgeFlow = &awstypes.AcknowledgeFlow{
+			Enabled: aws.Bool(v[0].(map[string]interface{})[names.AttrEnabled].(bool)),
+		}
+	}
+
+	if v, ok := tfMap["initialization_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.InitializationConfiguration = &awstypes.InitializationConfiguration{
+			DisabledOnInitialization: aws.Bool(v[0].(map[string]interface{})["disabled_on_initialization"].(bool)),
+		}
+	}
+
+	return apiObject
+}
+
+func expandAlarmEventActions(tfList []interface{}) *awstypes.AlarmEventActions {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	apiObject := &awstypes.AlarmEventActions{}
+
+	for _, tfMapRaw := range tfMap["alarm_action"].([]interface{}) {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject.AlarmActions = append(apiObject.AlarmActions, awstypes.AlarmAction{
+			Firehose:        expandFirehoseAction(tfMap["firehose"].([]interface{})),
+			IotEvents:       expandIoTEventsAction(tfMap["iot_events"].([]interface{})),
+			IotTopicPublish: expandIoTTopicPublishAction(tfMap["iot_topic_publish"].([]interface{})),
+			Lambda:          expandLambdaAction(tfMap["lambda"].([]interface{})),
+			Sns:             expandSNSTopicPublishAction(tfMap["sns"].([]interface{})),
+			Sqs:             expandSQSAction(tfMap["sqs"].([]interface{})),
+		})
+	}
+
+	return apiObject
+}
+
+func expandAlarmNotification(tfList []interface{}) *awstypes.AlarmNotification {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	apiObject := &awstypes.AlarmNotification{}
+
+	for _, tfMapRaw := range tfMap["notification_action"].([]interface{}) {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		notificationAction := awstypes.NotificationAction{}
+
+		if v, ok := tfMap[names.AttrAction].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			notificationAction.Action = &awstypes.NotificationTargetActions{
+				LambdaAction: expandLambdaAction(v[0].(map[string]interface{})["lambda_action"].([]interface{})),
+			}
+		}
+
+		for _, tfMapRaw := range tfMap["email_configuration"].([]interface{}) {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+
+			if !ok {
+				continue
+			}
+
+			emailConfiguration := awstypes.EmailConfiguration{
+				From: aws.String(tfMap["from"].(string)),
+			}
+
+			if v, ok := tfMap["content"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+				tfMap := v[0].(map[string]interface{})
+				emailConfiguration.Content = &awstypes.EmailContent{}
+
+				if v, ok := tfMap["additional_message"].(string); ok && v != "" {
+					emailConfiguration.Content.AdditionalMessage = aws.String(v)
+				}
+
+				if v, ok := tfMap["subject"].(string); ok && v != "" {
+					emailConfiguration.Content.Subject = aws.String(v)
+				}
+			}
+
+			if v, ok := tfMap["recipients"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+				emailConfiguration.Recipients = &awstypes.EmailRecipients{
+					To: expandRecipientDetails(v[0].(map[string]interface{})["to"].([]interface{})),
+				}
+			}
+
+			notificationAction.EmailConfigurations = append(notificationAction.EmailConfigurations, emailConfiguration)
+		}
+
+		for _, tfMapRaw := range tfMap["sms_configuration"].([]interface{}) {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+
+			if !ok {
+				continue
+			}
+
+			smsConfiguration := awstypes.SMSConfiguration{
+				Recipients: expandRecipientDetails(tfMap["recipients"].([]interface{})),
+			}
+
+			if v, ok := tfMap["additional_message"].(string); ok && v != "" {
+				smsConfiguration.AdditionalMessage = aws.String(v)
+			}
+
+			if v, ok := tfMap["sender_id"].(string); ok && v != "" {
+				smsConfiguration.SenderId = aws.String(v)
+			}
+
+			notificationAction.SmsConfigurations = append(notificationAction.SmsConfigurations, smsConfiguration)
+		}
+
+		apiObject.NotificationActions = append(apiObject.NotificationActions, notificationAction)
+	}
+
+	return apiObject
+}
+
+func expandRecipientDetails(tfList []interface{}) []awstypes.RecipientDetail {
+	var apiObjects []awstypes.RecipientDetail
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := awstypes.RecipientDetail{}
+
+		if v, ok := tfMap["sso_identity"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+			apiObject.SsoIdentity = &awstypes.SSOIdentity{
+				IdentityStoreId: aws.String(tfMap["identity_store_id"].(string)),
+			}
+
+			if v, ok := tfMap["user_id"].(string); ok && v != "" {
+				apiObject.SsoIdentity.UserId = aws.String(v)
+			}
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenAlarmRule(apiObject *awstypes.AlarmRule) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.SimpleRule; v != nil {
+		tfMap["simple_rule"] = []interface{}{map[string]interface{}{
+			"comparison_operator": string(v.ComparisonOperator),
+			"input_property":      aws.ToString(v.InputProperty),
+			"threshold":           aws.ToString(v.Threshold),
+		}}
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenAlarmCapabilities(apiObject *awstypes.AlarmCapabilities) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.AcknowledgeFlow; v != nil {
+		tfMap["acknowledge_flow"] = []interface{}{map[string]interface{}{
+			names.AttrEnabled: aws.ToBool(v.Enabled),
+		}}
+	}
+
+	if v := apiObject.InitializationConfiguration; v != nil {
+		tfMap["initialization_configuration"] = []interface{}{map[string]interface{}{
+			"disabled_on_initialization": aws.ToBool(v.DisabledOnInitialization),
+		}}
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenAlarmEventActions(apiObject *awstypes.AlarmEventActions) []interface{} {
+	if apiObject == nil || len(apiObject.AlarmActions) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObject.AlarmActions {
+		tfList = append(tfList, map[string]interface{}{
+			"firehose":          flattenFirehoseAction(apiObject.Firehose),
+			"iot_events":        flattenIoTEventsAction(apiObject.IotEvents),
+			"iot_topic_publish": flattenIoTTopicPublishAction(apiObject.IotTopicPublish),
+			"lambda":            flattenLambdaAction(apiObject.Lambda),
+			"sns":               flattenSNSTopicPublishAction(apiObject.Sns),
+			"sqs":               flattenSQSAction(apiObject.Sqs),
+		})
+	}
+
+	return []interface{}{map[string]interface{}{
+		"alarm_action": tfList,
+	}}
+}
+
+func flattenAlarmNotification(apiObject *awstypes.AlarmNotification) []interface{} {
+	if apiObject == nil || len(apiObject.NotificationActions) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObject.NotificationActions {
+		tfMap := map[string]interface{}{}
+
+		if v := apiObject.Action; v != nil {
+			tfMap[names.AttrAction] = []interface{}{map[string]interface{}{
+				"lambda_action": flattenLambdaAction(v.LambdaAction),
+			}}
+		}
+
+		var emailConfigurations []interface{}
+
+		for _, apiObject := range apiObject.EmailConfigurations {
+			tfMap := map[string]interface{}{
+				"from": aws.ToString(apiObject.From),
+			}
+
+			if v := apiObject.Content; v != nil {
+				tfMap["content"] = []interface{}{map[string]interface{}{
+					"additional_message": aws.ToString(v.AdditionalMessage),
+					"subject":            aws.ToString(v.Subject),
+				}}
+			}
+
+			if v := apiObject.Recipients; v != nil {
+				tfMap["recipients"] = []interface{}{map[string]interface{}{
+					"to": flattenRecipientDetails(v.To),
+				}}
+			}
+
+			emailConfigurations = append(emailConfigurations, tfMap)
+		}
+
+		tfMap["email_configuration"] = emailConfigurations
+
+		var smsConfigurations []interface{}
+
+		for _, apiObject := range apiObject.SmsConfigurations {
+			smsConfigurations = append(smsConfigurations, map[string]interface{}{
+				"additional_message": aws.ToString(apiObject.AdditionalMessage),
+				"recipients":         flattenRecipientDetails(apiObject.Recipients),
+				"sender_id":          aws.ToString(apiObject.SenderId),
+			})
+		}
+
+		tfMap["sms_configuration"] = smsConfigurations
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return []interface{}{map[string]interface{}{
+		"notification_action": tfList,
+	}}
+}
+
+func flattenRecipientDetails(apiObjects []awstypes.RecipientDetail) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		tfMap := map[string]interface{}{}
+
+		if v := apiObject.SsoIdentity; v != nil {
+			tfMap["sso_identity"] = []interface{}{map[string]interface{}{
+				"identity_store_id": aws.ToString(v.IdentityStoreId),
+				"user_id":           aws.ToString(v.UserId),
+			}}
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
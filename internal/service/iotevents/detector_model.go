@@ -0,0 +1,1128 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iotevents
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/iotevents"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/iotevents/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tfjson "github.com/hashicorp/terraform-provider-aws/internal/json"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_iotevents_detector_model", name="Detector Model")
+// @Tags(identifierAttribute="arn")
+func resourceDetectorModel() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceDetectorModelCreate,
+		ReadWithoutTimeout:   resourceDetectorModelRead,
+		UpdateWithoutTimeout: resourceDetectorModelUpdate,
+		DeleteWithoutTimeout: resourceDetectorModelDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"definition": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ExactlyOneOf:     []string{"definition", names.AttrState},
+				ValidateFunc:     validation.StringIsJSON,
+				DiffSuppressFunc: verify.SuppressEquivalentJSONDiffs,
+				StateFunc: func(v interface{}) string {
+					json, _ := structure.NormalizeJsonString(v)
+					return json
+				},
+			},
+			names.AttrDescription: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 128),
+			},
+			"evaluation_method": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ValidateDiagFunc: enum.Validate[awstypes.EvaluationMethod](),
+			},
+			"initial_state_name": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"definition"},
+				RequiredWith:  []string{names.AttrState},
+				ValidateFunc:  validation.StringLenBetween(1, 128),
+			},
+			names.AttrKey: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 128),
+			},
+			names.AttrName: {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 128),
+					validation.StringMatch(regexache.MustCompile(`^[0-9A-Za-z_-]+$`), "must contain only alphanumeric characters, hyphens and underscores"),
+				),
+			},
+			names.AttrRoleARN: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			names.AttrState: {
+				Type:          schema.TypeList,
+				Optional:      true,
+				Computed:      true,
+				MinItems:      1,
+				ConflictsWith: []string{"definition"},
+				RequiredWith:  []string{"initial_state_name"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrName: {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 128),
+						},
+						"on_enter": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"event": detectorModelEventSchema(),
+								},
+							},
+						},
+						"on_exit": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"event": detectorModelEventSchema(),
+								},
+							},
+						},
+						"on_input": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"event": detectorModelEventSchema(),
+									"transition_event": {
+										Type:     schema.TypeList,
+										Optional: true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												names.AttrAction: detectorModelActionSchema(),
+												names.AttrCondition: {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringLenBetween(1, 512),
+												},
+												names.AttrName: {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringLenBetween(1, 128),
+												},
+												"next_state": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringLenBetween(1, 128),
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			names.AttrStatus: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			names.AttrVersion: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+
+		CustomizeDiff: customdiff.Sequence(
+			verify.SetTagsDiff,
+			// The JSON and structured forms of the definition are views of the same API object.
+			func(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+				if d.Id() == "" {
+					return nil
+				}
+
+				if d.HasChange("definition") {
+					if err := d.SetNewComputed("initial_state_name"); err != nil {
+						return err
+					}
+
+					return d.SetNewComputed(names.AttrState)
+				}
+
+				if d.HasChanges("initial_state_name", names.AttrState) {
+					return d.SetNewComputed("definition")
+				}
+
+				return nil
+			},
+		),
+	}
+}
+
+func detectorModelEventSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				names.AttrAction: detectorModelActionSchema(),
+				names.AttrCondition: {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.StringLenBetween(1, 512),
+				},
+				names.AttrName: {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringLenBetween(1, 128),
+				},
+			},
+		},
+	}
+}
+
+func detectorModelActionSchema() *schema.Schema {
+	s := targetActionSchemaMap()
+
+	s["clear_timer"] = timerNameActionSchema()
+	s["reset_timer"] = timerNameActionSchema()
+	s["set_timer"] = &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"duration_expression": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringLenBetween(1, 1024),
+				},
+				"timer_name": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringLenBetween(1, 128),
+				},
+			},
+		},
+	}
+	s["set_variable"] = &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				names.AttrValue: {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringLenBetween(1, 1024),
+				},
+				"variable_name": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringLenBetween(1, 128),
+				},
+			},
+		},
+	}
+
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Elem: &schema.Resource{
+			Schema: s,
+		},
+	}
+}
+
+func timerNameActionSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"timer_name": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringLenBetween(1, 128),
+				},
+			},
+		},
+	}
+}
+
+// targetActionSchemaMap returns the schema for the actions that send data to other AWS services.
+// These are common to detector model events and alarm model event actions.
+func targetActionSchemaMap() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"firehose": {
+			Type:     schema.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"delivery_stream_name": {
+						Type:     schema.TypeString,
+						Required: true,
+					},
+					"payload": payloadSchema(),
+					"separator": {
+						Type:         schema.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringInSlice([]string{"\n", "\t", "\r\n", ","}, false),
+					},
+				},
+			},
+		},
+		"iot_events": {
+			Type:     schema.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"input_name": {
+						Type:         schema.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringLenBetween(1, 128),
+					},
+					"payload": payloadSchema(),
+				},
+			},
+		},
+		"iot_topic_publish": {
+			Type:     schema.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"mqtt_topic": {
+						Type:         schema.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringLenBetween(1, 128),
+					},
+					"payload": payloadSchema(),
+				},
+			},
+		},
+		"lambda": {
+			Type:     schema.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					names.AttrFunctionARN: {
+						Type:         schema.TypeString,
+						Required:     true,
+						ValidateFunc: verify.ValidARN,
+					},
+					"payload": payloadSchema(),
+				},
+			},
+		},
+		"sns": {
+			Type:     schema.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"payload": payloadSchema(),
+					names.AttrTargetARN: {
+						Type:         schema.TypeString,
+						Required:     true,
+						ValidateFunc: verify.ValidARN,
+					},
+				},
+			},
+		},
+		"sqs": {
+			Type:     schema.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"payload": payloadSchema(),
+					"queue_url": {
+						Type:     schema.TypeString,
+						Required: true,
+					},
+					"use_base64": {
+						Type:     schema.TypeBool,
+						Optional: true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func payloadSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"content_expression": {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+				names.AttrType: {
+					Type:             schema.TypeString,
+					Required:         true,
+					ValidateDiagFunc: enum.Validate[awstypes.PayloadType](),
+				},
+			},
+		},
+	}
+}
+
+func resourceDetectorModelCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IoTEventsClient(ctx)
+
+	name := d.Get(names.AttrName).(string)
+	input := &iotevents.CreateDetectorModelInput{
+		DetectorModelName: aws.String(name),
+		RoleArn:           aws.String(d.Get(names.AttrRoleARN).(string)),
+		Tags:              getTagsIn(ctx),
+	}
+
+	definition, err := expandDetectorModelDefinitionFromResourceData(d)
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	input.DetectorModelDefinition = definition
+
+	if v, ok := d.GetOk(names.AttrDescription); ok {
+		input.DetectorModelDescription = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("evaluation_method"); ok {
+		input.EvaluationMethod = awstypes.EvaluationMethod(v.(string))
+	}
+
+	if v, ok := d.GetOk(names.AttrKey); ok {
+		input.Key = aws.String(v.(string))
+	}
+
+	_, err = conn.CreateDetectorModel(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating IoT Events Detector Model (%s): %s", name, err)
+	}
+
+	d.SetId(name)
+
+	if _, err := waitDetectorModelActive(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for IoT Events Detector Model (%s) create: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceDetectorModelRead(ctx, d, meta)...)
+}
+
+func resourceDetectorModelRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IoTEventsClient(ctx)
+
+	output, err := findDetectorModelByName(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] IoT Events Detector Model (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading IoT Events Detector Model (%s): %s", d.Id(), err)
+	}
+
+	configuration := output.DetectorModelConfiguration
+	d.Set(names.AttrARN, configuration.DetectorModelArn)
+	d.Set(names.AttrDescription, configuration.DetectorModelDescription)
+	d.Set("evaluation_method", configuration.EvaluationMethod)
+	d.Set(names.AttrKey, configuration.Key)
+	d.Set(names.AttrName, configuration.DetectorModelName)
+	d.Set(names.AttrRoleARN, configuration.RoleArn)
+	d.Set(names.AttrStatus, configuration.Status)
+	d.Set(names.AttrVersion, configuration.DetectorModelVersion)
+
+	if definition := output.DetectorModelDefinition; definition != nil {
+		v, err := flattenDetectorModelDefinitionJSON(definition)
+
+		if err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+
+		d.Set("definition", v)
+		d.Set("initial_state_name", definition.InitialStateName)
+		if err := d.Set(names.AttrState, flattenStates(definition.States)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting state: %s", err)
+		}
+	} else {
+		d.Set("definition", nil)
+		d.Set("initial_state_name", nil)
+		d.Set(names.AttrState, nil)
+	}
+
+	return diags
+}
+
+func resourceDetectorModelUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IoTEventsClient(ctx)
+
+	if d.HasChangesExcept(names.AttrTags, names.AttrTagsAll) {
+		input := &iotevents.UpdateDetectorModelInput{
+			DetectorModelDescription: aws.String(d.Get(names.AttrDescription).(string)),
+			DetectorModelName:        aws.String(d.Id()),
+			RoleArn:                  aws.String(d.Get(names.AttrRoleARN).(string)),
+		}
+
+		definition, err := expandDetectorModelDefinitionFromResourceData(d)
+
+		if err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+
+		input.DetectorModelDefinition = definition
+
+		if v, ok := d.GetOk("evaluation_method"); ok {
+			input.EvaluationMethod = awstypes.EvaluationMethod(v.(string))
+		}
+
+		_, err = conn.UpdateDetectorModel(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating IoT Events Detector Model (%s): %s", d.Id(), err)
+		}
+
+		if _, err := waitDetectorModelActive(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for IoT Events Detector Model (%s) update: %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceDetectorModelRead(ctx, d, meta)...)
+}
+
+func resourceDetectorModelDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IoTEventsClient(ctx)
+
+	log.Printf("[DEBUG] Deleting IoT Events Detector Model: %s", d.Id())
+	_, err := conn.DeleteDetectorModel(ctx, &iotevents.DeleteDetectorModelInput{
+		DetectorModelName: aws.String(d.Id()),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting IoT Events Detector Model (%s): %s", d.Id(), err)
+	}
+
+	_, err = tfresource.RetryUntilNotFound(ctx, d.Timeout(schema.TimeoutDelete), func() (interface{}, error) {
+		return findDetectorModelByName(ctx, conn, d.Id())
+	})
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for IoT Events Detector Model (%s) delete: %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func findDetectorModelByName(ctx context.Context, conn *iotevents.Client, name string) (*awstypes.DetectorModel, error) {
+	input := &iotevents.DescribeDetectorModelInput{
+		DetectorModelName: aws.String(name),
+	}
+
+	output, err := conn.DescribeDetectorModel(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.DetectorModel == nil || output.DetectorModel.DetectorModelConfiguration == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.DetectorModel, nil
+}
+
+func statusDetectorModel(ctx context.Context, conn *iotevents.Client, name string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findDetectorModelByName(ctx, conn, name)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.DetectorModelConfiguration.Status), nil
+	}
+}
+
+func waitDetectorModelActive(ctx context.Context, conn *iotevents.Client, name string, timeout time.Duration) (*awstypes.DetectorModel, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.DetectorModelVersionStatusActivating),
+		Target:  enum.Slice(awstypes.DetectorModelVersionStatusActive),
+		Refresh: statusDetectorModel(ctx, conn, name),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.DetectorModel); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+// expandDetectorModelDefinitionFromResourceData returns the detector model definition from
+// either the JSON `definition` or the structured `initial_state_name` and `state` arguments.
+// Both are Computed, so the raw configuration is used to determine which form is in use.
+func expandDetectorModelDefinitionFromResourceData(d *schema.ResourceData) (*awstypes.DetectorModelDefinition, error) {
+	if v := d.GetRawConfig().GetAttr("definition"); v.IsKnown() && !v.IsNull() {
+		apiObject := &awstypes.DetectorModelDefinition{}
+
+		// encoding/json matches object keys to exported field names case-insensitively.
+		if err := json.Unmarshal([]byte(d.Get("definition").(string)), apiObject); err != nil {
+			return nil, fmt.Errorf("reading definition: %w", err)
+		}
+
+		return apiObject, nil
+	}
+
+	apiObject := &awstypes.DetectorModelDefinition{
+		InitialStateName: aws.String(d.Get("initial_state_name").(string)),
+		States:           expandStates(d.Get(names.AttrState).([]interface{})),
+	}
+
+	if len(apiObject.States) == 0 {
+		return nil, errors.New(`one of "definition" or "state" must be configured`)
+	}
+
+	return apiObject, nil
+}
+
+func expandStates(tfList []interface{}) []awstypes.State {
+	var apiObjects []awstypes.State
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := awstypes.State{
+			StateName: aws.String(tfMap[names.AttrName].(string)),
+		}
+
+		if v, ok := tfMap["on_enter"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+			apiObject.OnEnter = &awstypes.OnEnterLifecycle{
+				Events: expandEvents(tfMap["event"].([]interface{})),
+			}
+		}
+
+		if v, ok := tfMap["on_exit"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+			apiObject.OnExit = &awstypes.OnExitLifecycle{
+				Events: expandEvents(tfMap["event"].([]interface{})),
+			}
+		}
+
+		if v, ok := tfMap["on_input"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+			apiObject.OnInput = &awstypes.OnInputLifecycle{
+				Events:           expandEvents(tfMap["event"].([]interface{})),
+				TransitionEvents: expandTransitionEvents(tfMap["transition_event"].([]interface{})),
+			}
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandEvents(tfList []interface{}) []awstypes.Event {
+	var apiObjects []awstypes.Event
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := awstypes.Event{
+			Actions:   expandActions(tfMap[names.AttrAction].([]interface{})),
+			EventName: aws.String(tfMap[names.AttrName].(string)),
+		}
+
+		if v, ok := tfMap[names.AttrCondition].(string); ok && v != "" {
+			apiObject.Condition = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandTransitionEvents(tfList []interface{}) []awstypes.TransitionEvent {
+	var apiObjects []awstypes.TransitionEvent
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, awstypes.TransitionEvent{
+			Actions:   expandActions(tfMap[names.AttrAction].([]interface{})),
+			Condition: aws.String(tfMap[names.AttrCondition].(string)),
+			EventName: aws.String(tfMap[names.AttrName].(string)),
+			NextState: aws.String(tfMap["next_state"].(string)),
+		})
+	}
+
+	return apiObjects
+}
+
+func expandActions(tfList []interface{}) []awstypes.Action {
+	var apiObjects []awstypes.Action
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := awstypes.Action{
+			Firehose:        expandFirehoseAction(tfMap["firehose"].([]interface{})),
+			IotEvents:       expandIoTEventsAction(tfMap["iot_events"].([]interface{})),
+			IotTopicPublish: expandIoTTopicPublishAction(tfMap["iot_topic_publish"].([]interface{})),
+			Lambda:          expandLambdaAction(tfMap["lambda"].([]interface{})),
+			Sns:             expandSNSTopicPublishAction(tfMap["sns"].([]interface{})),
+			Sqs:             expandSQSAction(tfMap["sqs"].([]interface{})),
+		}
+
+		if v, ok := tfMap["clear_timer"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.ClearTimer = &awstypes.ClearTimerAction{
+				TimerName: aws.String(v[0].(map[string]interface{})["timer_name"].(string)),
+			}
+		}
+
+		if v, ok := tfMap["reset_timer"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.ResetTimer = &awstypes.ResetTimerAction{
+				TimerName: aws.String(v[0].(map[string]interface{})["timer_name"].(string)),
+			}
+		}
+
+		if v, ok := tfMap["set_timer"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+			apiObject.SetTimer = &awstypes.SetTimerAction{
+				DurationExpression: aws.String(tfMap["duration_expression"].(string)),
+				TimerName:          aws.String(tfMap["timer_name"].(string)),
+			}
+		}
+
+		if v, ok := tfMap["set_variable"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+			apiObject.SetVariable = &awstypes.SetVariableAction{
+				Value:        aws.String(tfMap[names.AttrValue].(string)),
+				VariableName: aws.String(tfMap["variable_name"].(string)),
+			}
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandFirehoseAction(tfList []interface{}) *awstypes.FirehoseAction {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	apiObject := &awstypes.FirehoseAction{
+		DeliveryStreamName: aws.String(tfMap["delivery_stream_name"].(string)),
+		Payload:            expandPayload(tfMap["payload"].([]interface{})),
+	}
+
+	if v, ok := tfMap["separator"].(string); ok && v != "" {
+		apiObject.Separator = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandIoTEventsAction(tfList []interface{}) *awstypes.IotEventsAction {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	return &awstypes.IotEventsAction{
+		InputName: aws.String(tfMap["input_name"].(string)),
+		Payload:   expandPayload(tfMap["payload"].([]interface{})),
+	}
+}
+
+func expandIoTTopicPublishAction(tfList []interface{}) *awstypes.IotTopicPublishAction {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	return &awstypes.IotTopicPublishAction{
+		MqttTopic: aws.String(tfMap["mqtt_topic"].(string)),
+		Payload:   expandPayload(tfMap["payload"].([]interface{})),
+	}
+}
+
+func expandLambdaAction(tfList []interface{}) *awstypes.LambdaAction {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	return &awstypes.LambdaAction{
+		FunctionArn: aws.String(tfMap[names.AttrFunctionARN].(string)),
+		Payload:     expandPayload(tfMap["payload"].([]interface{})),
+	}
+}
+
+func expandSNSTopicPublishAction(tfList []interface{}) *awstypes.SNSTopicPublishAction {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	return &awstypes.SNSTopicPublishAction{
+		Payload:   expandPayload(tfMap["payload"].([]interface{})),
+		TargetArn: aws.String(tfMap[names.AttrTargetARN].(string)),
+	}
+}
+
+func expandSQSAction(tfList []interface{}) *awstypes.SqsAction {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	return &awstypes.SqsAction{
+		Payload:   expandPayload(tfMap["payload"].([]interface{})),
+		QueueUrl:  aws.String(tfMap["queue_url"].(string)),
+		UseBase64: aws.Bool(tfMap["use_base64"].(bool)),
+	}
+}
+
+func expandPayload(tfList []interface{}) *awstypes.Payload {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	return &awstypes.Payload{
+		ContentExpression: aws.String(tfMap["content_expression"].(string)),
+		Type:              awstypes.PayloadType(tfMap[names.AttrType].(string)),
+	}
+}
+
+// flattenDetectorModelDefinitionJSON returns the JSON form of a detector model definition,
+// with the AWS API member names as object keys and unset fields omitted.
+func flattenDetectorModelDefinitionJSON(apiObject *awstypes.DetectorModelDefinition) (string, error) {
+	v, err := json.Marshal(apiObject)
+
+	if err != nil {
+		return "", err
+	}
+
+	return string(tfjson.KeyFirstLower(tfjson.RemoveEmptyFields(v))), nil
+}
+
+func flattenStates(apiObjects []awstypes.State) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		tfMap := map[string]interface{}{
+			names.AttrName: aws.ToString(apiObject.StateName),
+		}
+
+		if v := apiObject.OnEnter; v != nil {
+			tfMap["on_enter"] = []interface{}{map[string]interface{}{
+				"event": flattenEvents(v.Events),
+			}}
+		}
+
+		if v := apiObject.OnExit; v != nil {
+			tfMap["on_exit"] = []interface{}{map[string]interface{}{
+				"event": flattenEvents(v.Events),
+			}}
+		}
+
+		if v := apiObject.OnInput; v != nil {
+			tfMap["on_input"] = []interface{}{map[string]interface{}{
+				"event":            flattenEvents(v.Events),
+				"transition_event": flattenTransitionEvents(v.TransitionEvents),
+			}}
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func flattenEvents(apiObjects []awstypes.Event) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, map[string]interface{}{
+			names.AttrAction:    flattenActions(apiObject.Actions),
+			names.AttrCondition: aws.ToString(apiObject.Condition),
+			names.AttrName:      aws.ToString(apiObject.EventName),
+		})
+	}
+
+	return tfList
+}
+
+func flattenTransitionEvents(apiObjects []awstypes.TransitionEvent) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, map[string]interface{}{
+			names.AttrAction:    flattenActions(apiObject.Actions),
+			names.AttrCondition: aws.ToString(apiObject.Condition),
+			names.AttrName:      aws.ToString(apiObject.EventName),
+			"next_state":        aws.ToString(apiObject.NextState),
+		})
+	}
+
+	return tfList
+}
+
+func flattenActions(apiObjects []awstypes.Action) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		tfMap := map[string]interface{}{
+			"firehose":          flattenFirehoseAction(apiObject.Firehose),
+			"iot_events":        flattenIoTEventsAction(apiObject.IotEvents),
+			"iot_topic_publish": flattenIoTTopicPublishAction(apiObject.IotTopicPublish),
+			"lambda":            flattenLambdaAction(apiObject.Lambda),
+			"sns":               flattenSNSTopicPublishAction(apiObject.Sns),
+			"sqs":               flattenSQSAction(apiObject.Sqs),
+		}
+
+		if v := apiObject.ClearTimer; v != nil {
+			tfMap["clear_timer"] = []interface{}{map[string]interface{}{
+				"timer_name": aws.ToString(v.TimerName),
+			}}
+		}
+
+		if v := apiObject.ResetTimer; v != nil {
+			tfMap["reset_timer"] = []interface{}{map[string]interface{}{
+				"timer_name": aws.ToString(v.TimerName),
+			}}
+		}
+
+		if v := apiObject.SetTimer; v != nil {
+			tfMap["set_timer"] = []interface{}{map[string]interface{}{
+				"duration_expression": aws.ToString(v.DurationExpression),
+				"timer_name":          aws.ToString(v.TimerName),
+			}}
+		}
+
+		if v := apiObject.SetVariable; v != nil {
+			tfMap["set_variable"] = []interface{}{map[string]interface{}{
+				names.AttrValue: aws.ToString(v.Value),
+				"variable_name": aws.ToString(v.VariableName),
+			}}
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func flattenFirehoseAction(apiObject *awstypes.FirehoseAction) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"delivery_stream_name": aws.ToString(apiObject.DeliveryStreamName),
+		"payload":              flattenPayload(apiObject.Payload),
+		"separator":            aws.ToString(apiObject.Separator),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenIoTEventsAction(apiObject *awstypes.IotEventsAction) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"input_name": aws.ToString(apiObject.InputName),
+		"payload":    flattenPayload(apiObject.Payload),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenIoTTopicPublishAction(apiObject *awstypes.IotTopicPublishAction) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"mqtt_topic": aws.ToString(apiObject.MqttTopic),
+		"payload":    flattenPayload(apiObject.Payload),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenLambdaAction(apiObject *awstypes.LambdaAction) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		names.AttrFunctionARN: aws.ToString(apiObject.FunctionArn),
+		"payload":             flattenPayload(apiObject.Payload),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenSNSTopicPublishAction(apiObject *awstypes.SNSTopicPublishAction) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"payload":           flattenPayload(apiObject.Payload),
+		names.AttrTargetARN: aws.ToString(apiObject.TargetArn),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenSQSAction(apiObject *awstypes.SqsAction) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"payload":    flattenPayload(apiObject.Payload),
+		"queue_url":  aws.ToString(apiObject.QueueUrl),
+		"use_base64": aws.ToBool(apiObject.UseBase64),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenPayload(apiObject *awstypes.Payload) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"content_expression": aws.ToString(apiObject.ContentExpression),
+		names.AttrType:       string(apiObject.Type),
+	}
+
+	return []interface{}{tfMap}
+}
@@ -0,0 +1,368 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iotevents_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/iotevents/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfiotevents "github.com/hashicorp/terraform-provider-aws/internal/service/iotevents"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccIoTEventsDetectorModel_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.DetectorModel
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_iotevents_detector_model.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, names.IoTEventsEndpointID) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.IoTEventsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDetectorModelDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDetectorModelConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDetectorModelExists(ctx, resourceName, &v),
+					acctest.MatchResourceAttrRegionalARN(resourceName, names.AttrARN, "iotevents", regexache.MustCompile(fmt.Sprintf("detectorModel/%s$", rName))),
+					resource.TestCheckResourceAttrSet(resourceName, "definition"),
+					resource.TestCheckResourceAttr(resourceName, "evaluation_method", string(awstypes.EvaluationMethodBatch)),
+					resource.TestCheckResourceAttr(resourceName, "initial_state_name", "Normal"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrRoleARN, "aws_iam_role.test", names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "state.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "state.0.name", "Normal"),
+					resource.TestCheckResourceAttr(resourceName, "state.0.on_input.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "state.0.on_input.0.event.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "state.0.on_input.0.event.0.action.0.set_variable.0.variable_name", "temperature"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, string(awstypes.DetectorModelVersionStatusActive)),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct0),
+					resource.TestCheckResourceAttr(resourceName, names.AttrVersion, acctest.Ct1),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"definition"},
+			},
+		},
+	})
+}
+
+func TestAccIoTEventsDetectorModel_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.DetectorModel
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_iotevents_detector_model.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, names.IoTEventsEndpointID) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.IoTEventsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDetectorModelDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDetectorModelConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDetectorModelExists(ctx, resourceName, &v),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfiotevents.ResourceDetectorModel(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccIoTEventsDetectorModel_definition(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.DetectorModel
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_iotevents_detector_model.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, names.IoTEventsEndpointID) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.IoTEventsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDetectorModelDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDetectorModelConfig_definition(rName, "30"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDetectorModelExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "evaluation_method", string(awstypes.EvaluationMethodSerial)),
+					resource.TestCheckResourceAttr(resourceName, "initial_state_name", "Normal"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrKey, "motorid"),
+					resource.TestCheckResourceAttr(resourceName, "state.#", acctest.Ct2),
+					resource.TestCheckResourceAttr(resourceName, "state.0.on_input.0.transition_event.0.next_state", "Dangerous"),
+					resource.TestCheckResourceAttr(resourceName, "state.0.on_input.0.transition_event.0.condition", "$input."+strings.ReplaceAll(rName, "-", "_")+".temperature > 30"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrVersion, acctest.Ct1),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"definition"},
+			},
+			{
+				Config: testAccDetectorModelConfig_definition(rName, "40"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDetectorModelExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "state.0.on_input.0.transition_event.0.condition", "$input."+strings.ReplaceAll(rName, "-", "_")+".temperature > 40"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrVersion, acctest.Ct2),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIoTEventsDetectorModel_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.DetectorModel
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_iotevents_detector_model.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, names.IoTEventsEndpointID) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.IoTEventsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDetectorModelDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDetectorModelConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDetectorModelExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, ""),
+					resource.TestCheckResourceAttr(resourceName, "state.#", acctest.Ct1),
+				),
+			},
+			{
+				Config: testAccDetectorModelConfig_updated(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDetectorModelExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, "Motor overheating"),
+					resource.TestCheckResourceAttr(resourceName, "state.#", acctest.Ct2),
+					resource.TestCheckResourceAttr(resourceName, "state.0.on_input.0.transition_event.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "state.1.name", "Dangerous"),
+					resource.TestCheckResourceAttr(resourceName, "state.1.on_enter.0.event.0.action.0.set_timer.0.timer_name", "cooldown"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrVersion, acctest.Ct2),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckDetectorModelDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).IoTEventsClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_iotevents_detector_model" {
+				continue
+			}
+
+			_, err := tfiotevents.FindDetectorModelByName(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("IoT Events Detector Model %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckDetectorModelExists(ctx context.Context, n string, v *awstypes.DetectorModel) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).IoTEventsClient(ctx)
+
+		output, err := tfiotevents.FindDetectorModelByName(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccDetectorModelConfig_base(rName string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = "sts:AssumeRole"
+      Effect = "Allow"
+      Principal = {
+        Service = "iotevents.${data.aws_partition.current.dns_suffix}"
+      }
+    }]
+  })
+}
+`, rName)
+}
+
+func testAccDetectorModelConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccDetectorModelConfig_base(rName), fmt.Sprintf(`
+resource "aws_iotevents_detector_model" "test" {
+  name     = %[1]q
+  role_arn = aws_iam_role.test.arn
+
+  initial_state_name = "Normal"
+
+  state {
+    name = "Normal"
+
+    on_input {
+      event {
+        name      = "recordTemperature"
+        condition = "true"
+
+        action {
+          set_variable {
+            variable_name = "temperature"
+            value         = "42"
+          }
+        }
+      }
+    }
+  }
+}
+`, rName))
+}
+
+func testAccDetectorModelConfig_updated(rName string) string {
+	return acctest.ConfigCompose(testAccDetectorModelConfig_base(rName), fmt.Sprintf(`
+resource "aws_iotevents_detector_model" "test" {
+  name        = %[1]q
+  description = "Motor overheating"
+  role_arn    = aws_iam_role.test.arn
+
+  initial_state_name = "Normal"
+
+  state {
+    name = "Normal"
+
+    on_input {
+      event {
+        name      = "recordTemperature"
+        condition = "true"
+
+        action {
+          set_variable {
+            variable_name = "temperature"
+            value         = "42"
+          }
+        }
+      }
+
+      transition_event {
+        name       = "overheated"
+        condition  = "$variable.temperature > 40"
+        next_state = "Dangerous"
+      }
+    }
+  }
+
+  state {
+    name = "Dangerous"
+
+    on_enter {
+      event {
+        name = "startCooldown"
+
+        action {
+          set_timer {
+            timer_name          = "cooldown"
+            duration_expression = "300"
+          }
+        }
+      }
+    }
+  }
+}
+`, rName))
+}
+
+func testAccDetectorModelConfig_definition(rName, threshold string) string {
+	return acctest.ConfigCompose(testAccDetectorModelConfig_base(rName), fmt.Sprintf(`
+resource "aws_iotevents_input" "test" {
+  name = replace(%[1]q, "-", "_")
+
+  input_definition {
+    attribute {
+      json_path = "motorid"
+    }
+
+    attribute {
+      json_path = "temperature"
+    }
+  }
+}
+
+resource "aws_iotevents_detector_model" "test" {
+  name              = %[1]q
+  role_arn          = aws_iam_role.test.arn
+  evaluation_method = "SERIAL"
+  key               = "motorid"
+
+  definition = jsonencode({
+    initialStateName = "Normal"
+    states = [
+      {
+        stateName = "Normal"
+        onInput = {
+          transitionEvents = [{
+            eventName = "overheated"
+            condition = "$input.${aws_iotevents_input.test.name}.temperature > %[2]s"
+            nextState = "Dangerous"
+          }]
+        }
+      },
+      {
+        stateName = "Dangerous"
+        onInput = {
+          transitionEvents = [{
+            eventName = "cooled"
+            condition = "$input.${aws_iotevents_input.test.name}.temperature <= %[2]s"
+            nextState = "Normal"
+          }]
+        }
+      },
+    ]
+  })
+}
+`, rName, threshold))
+}
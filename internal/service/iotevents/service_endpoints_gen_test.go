@@ -4,17 +4,17 @@ package iotevents_test
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"maps"
-	"net/url"
 	"os"
 	"path/filepath"
 	"reflect"
 	"strings"
 	"testing"
 
-	"github.com/aws/aws-sdk-go/aws/endpoints"
-	iotevents_sdkv1 "github.com/aws/aws-sdk-go/service/iotevents"
+	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
+	iotevents_sdkv2 "github.com/aws/aws-sdk-go-v2/service/iotevents"
 	"github.com/aws/smithy-go/middleware"
 	smithyhttp "github.com/aws/smithy-go/transport/http"
 	"github.com/google/go-cmp/cmp"
@@ -213,32 +213,42 @@ func TestEndpointConfiguration(t *testing.T) { //nolint:paralleltest // uses t.S
 }
 
 func defaultEndpoint(region string) string {
-	r := endpoints.DefaultResolver()
+	r := iotevents_sdkv2.NewDefaultEndpointResolverV2()
 
-	ep, err := r.EndpointFor(iotevents_sdkv1.EndpointsID, region)
+	ep, err := r.ResolveEndpoint(context.Background(), iotevents_sdkv2.EndpointParameters{
+		Region: aws_sdkv2.String(region),
+	})
 	if err != nil {
 		return err.Error()
 	}
 
-	url, _ := url.Parse(ep.URL)
-
-	if url.Path == "" {
-		url.Path = "/"
+	if ep.URI.Path == "" {
+		ep.URI.Path = "/"
 	}
 
-	return url.String()
+	return ep.URI.String()
 }
 
 func callService(ctx context.Context, t *testing.T, meta *conns.AWSClient) string {
 	t.Helper()
 
-	client := meta.IoTEventsConn(ctx)
-
-	req, _ := client.ListAlarmModelsRequest(&iotevents_sdkv1.ListAlarmModelsInput{})
+	var endpoint string
 
-	req.HTTPRequest.URL.Path = "/"
+	client := meta.IoTEventsClient(ctx)
 
-	endpoint := req.HTTPRequest.URL.String()
+	_, err := client.ListAlarmModels(ctx, &iotevents_sdkv2.ListAlarmModelsInput{},
+		func(opts *iotevents_sdkv2.Options) {
+			opts.APIOptions = append(opts.APIOptions,
+				addRetrieveEndpointURLMiddleware(t, &endpoint),
+				addCancelRequestMiddleware(),
+			)
+		},
+	)
+	if err == nil {
+		t.Fatal("Expected an error, got none")
+	} else if !errors.Is(err, errCancelOperation) {
+		t.Fatalf("Unexpected error: %s", err)
+	}
 
 	return endpoint
 }
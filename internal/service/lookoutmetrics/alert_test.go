@@ -0,0 +1,195 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package lookoutmetrics_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/lookoutmetrics/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tflookoutmetrics "github.com/hashicorp/terraform-provider-aws/internal/service/lookoutmetrics"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccLookoutMetricsAlert_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.Alert
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_lookoutmetrics_alert.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.LookoutMetricsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAlertDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAlertConfig_basic(rName, 50),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAlertExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "action.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "action.0.lambda_configuration.#", acctest.Ct0),
+					resource.TestCheckResourceAttr(resourceName, "action.0.sns_configuration.#", acctest.Ct1),
+					resource.TestCheckResourceAttrPair(resourceName, "action.0.sns_configuration.0.role_arn", "aws_iam_role.test", names.AttrARN),
+					resource.TestCheckResourceAttrPair(resourceName, "action.0.sns_configuration.0.sns_topic_arn", "aws_sns_topic.test", names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "alert_sensitivity_threshold", "50"),
+					resource.TestCheckResourceAttrPair(resourceName, "anomaly_detector_arn", "aws_lookoutmetrics_anomaly_detector.test", names.AttrARN),
+					acctest.MatchResourceAttrRegionalARN(resourceName, names.AttrARN, "lookoutmetrics", regexache.MustCompile(`Alert:.+$`)),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct0),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAlertConfig_basic(rName, 80),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAlertExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "alert_sensitivity_threshold", "80"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccLookoutMetricsAlert_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.Alert
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_lookoutmetrics_alert.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.LookoutMetricsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAlertDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAlertConfig_basic(rName, 50),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAlertExists(ctx, resourceName, &v),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tflookoutmetrics.ResourceAlert, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAlertDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).LookoutMetricsClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_lookoutmetrics_alert" {
+				continue
+			}
+
+			_, err := tflookoutmetrics.FindAlertByARN(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Lookout for Metrics Alert %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckAlertExists(ctx context.Context, n string, v *awstypes.Alert) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).LookoutMetricsClient(ctx)
+
+		output, err := tflookoutmetrics.FindAlertByARN(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccAlertConfig_basic(rName string, threshold int) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_sns_topic" "test" {
+  name = %[1]q
+}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Effect = "Allow"
+      Principal = {
+        Service = "lookoutmetrics.${data.aws_partition.current.dns_suffix}"
+      }
+      Action = "sts:AssumeRole"
+    }]
+  })
+}
+
+resource "aws_iam_role_policy" "test" {
+  name = %[1]q
+  role = aws_iam_role.test.id
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Effect   = "Allow"
+      Action   = "sns:Publish"
+      Resource = aws_sns_topic.test.arn
+    }]
+  })
+}
+
+resource "aws_lookoutmetrics_anomaly_detector" "test" {
+  name      = %[1]q
+  frequency = "PT1H"
+}
+
+resource "aws_lookoutmetrics_alert" "test" {
+  name                        = %[1]q
+  anomaly_detector_arn        = aws_lookoutmetrics_anomaly_detector.test.arn
+  alert_sensitivity_threshold = %[2]d
+
+  action {
+    sns_configuration {
+      role_arn      = aws_iam_role.test.arn
+      sns_topic_arn = aws_sns_topic.test.arn
+    }
+  }
+
+  depends_on = [aws_iam_role_policy.test]
+}
+`, rName, threshold)
+}
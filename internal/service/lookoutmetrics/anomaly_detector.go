@@ -0,0 +1,485 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package lookoutmetrics
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lookoutmetrics"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/lookoutmetrics/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	anomalyDetectorStateActive   = "ACTIVE"
+	anomalyDetectorStateInactive = "INACTIVE"
+)
+
+// @FrameworkResource("aws_lookoutmetrics_anomaly_detector", name="Anomaly Detector")
+// @Tags(identifierAttribute="arn")
+func newAnomalyDetectorResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &anomalyDetectorResource{}
+
+	r.SetDefaultCreateTimeout(30 * time.Minute)
+	r.SetDefaultUpdateTimeout(30 * time.Minute)
+	r.SetDefaultDeleteTimeout(30 * time.Minute)
+
+	return r, nil
+}
+
+type anomalyDetectorResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+	framework.WithTimeouts
+}
+
+func (*anomalyDetectorResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_lookoutmetrics_anomaly_detector"
+}
+
+func (r *anomalyDetectorResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			names.AttrDescription: schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(256),
+				},
+			},
+			"frequency": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.Frequency](),
+				Required:   true,
+			},
+			names.AttrID: framework.IDAttribute(),
+			names.AttrKMSKeyARN: schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Optional:   true,
+			},
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 63),
+					stringvalidator.RegexMatches(regexache.MustCompile(`^[0-9A-Za-z_-]+$`), "must contain only alphanumeric characters, hyphens and underscores"),
+				},
+			},
+			names.AttrState: schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString(anomalyDetectorStateInactive),
+				Validators: []validator.String{
+					stringvalidator.OneOf(anomalyDetectorStateActive, anomalyDetectorStateInactive),
+				},
+			},
+			names.AttrStatus: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.AnomalyDetectorStatus](),
+				Computed:   true,
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+		},
+		Blocks: map[string]schema.Block{
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *anomalyDetectorResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data anomalyDetectorResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().LookoutMetricsClient(ctx)
+
+	input := &lookoutmetrics.CreateAnomalyDetectorInput{}
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Additional fields.
+	input.AnomalyDetectorConfig = &awstypes.AnomalyDetectorConfig{
+		AnomalyDetectorFrequency: data.Frequency.ValueEnum(),
+	}
+	input.Tags = getTagsIn(ctx)
+
+	output, err := conn.CreateAnomalyDetector(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating Lookout for Metrics Anomaly Detector (%s)", data.AnomalyDetectorName.ValueString()), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.AnomalyDetectorARN = fwflex.StringToFramework(ctx, output.AnomalyDetectorArn)
+	data.setID()
+
+	if data.State.ValueString() == anomalyDetectorStateActive {
+		if err := activateAnomalyDetector(ctx, conn, data.ID.ValueString(), r.CreateTimeout(ctx, data.Timeouts)); err != nil {
+			response.State.SetAttribute(ctx, path.Root(names.AttrID), data.ID) // Set 'id' so as to taint the resource.
+			response.Diagnostics.AddError(fmt.Sprintf("activating Lookout for Metrics Anomaly Detector (%s)", data.ID.ValueString()), err.Error())
+
+			return
+		}
+	}
+
+	detector, err := findAnomalyDetectorByARN(ctx, conn, data.ID.ValueString())
+
+	if err != nil {
+		response.State.SetAttribute(ctx, path.Root(names.AttrID), data.ID) // Set 'id' so as to taint the resource.
+		response.Diagnostics.AddError(fmt.Sprintf("reading Lookout for Metrics Anomaly Detector (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	data.Status = fwtypes.StringEnumValue(detector.Status)
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *anomalyDetectorResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data anomalyDetectorResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if err := data.InitFromID(); err != nil {
+		response.Diagnostics.AddError("parsing resource ID", err.Error())
+
+		return
+	}
+
+	conn := r.Meta().LookoutMetricsClient(ctx)
+
+	output, err := findAnomalyDetectorByARN(ctx, conn, data.ID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Lookout for Metrics Anomaly Detector (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if output.AnomalyDetectorConfig != nil {
+		data.Frequency = fwtypes.StringEnumValue(output.AnomalyDetectorConfig.AnomalyDetectorFrequency)
+	}
+	data.State = types.StringValue(anomalyDetectorStateFromStatus(output.Status))
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *anomalyDetectorResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new anomalyDetectorResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().LookoutMetricsClient(ctx)
+
+	if !new.AnomalyDetectorDescription.Equal(old.AnomalyDetectorDescription) ||
+		!new.Frequency.Equal(old.Frequency) ||
+		!new.KMSKeyARN.Equal(old.KMSKeyARN) {
+		input := &lookoutmetrics.UpdateAnomalyDetectorInput{
+			AnomalyDetectorArn:         aws.String(new.ID.ValueString()),
+			AnomalyDetectorDescription: fwflex.StringFromFramework(ctx, new.AnomalyDetectorDescription),
+			KmsKeyArn:                  fwflex.StringFromFramework(ctx, new.KMSKeyARN),
+		}
+
+		if !new.Frequency.Equal(old.Frequency) {
+			input.AnomalyDetectorConfig = &awstypes.AnomalyDetectorConfig{
+				AnomalyDetectorFrequency: new.Frequency.ValueEnum(),
+			}
+		}
+
+		_, err := conn.UpdateAnomalyDetector(ctx, input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating Lookout for Metrics Anomaly Detector (%s)", new.ID.ValueString()), err.Error())
+
+			return
+		}
+	}
+
+	if !new.State.Equal(old.State) {
+		var err error
+		switch new.State.ValueString() {
+		case anomalyDetectorStateActive:
+			err = activateAnomalyDetector(ctx, conn, new.ID.ValueString(), r.UpdateTimeout(ctx, new.Timeouts))
+		case anomalyDetectorStateInactive:
+			err = deactivateAnomalyDetector(ctx, conn, new.ID.ValueString(), r.UpdateTimeout(ctx, new.Timeouts))
+		}
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating Lookout for Metrics Anomaly Detector (%s) state", new.ID.ValueString()), err.Error())
+
+			return
+		}
+	}
+
+	output, err := findAnomalyDetectorByARN(ctx, conn, new.ID.ValueString())
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Lookout for Metrics Anomaly Detector (%s)", new.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	new.Status = fwtypes.StringEnumValue(output.Status)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *anomalyDetectorResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data anomalyDetectorResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().LookoutMetricsClient(ctx)
+
+	_, err := conn.DeleteAnomalyDetector(ctx, &lookoutmetrics.DeleteAnomalyDetectorInput{
+		AnomalyDetectorArn: aws.String(data.ID.ValueString()),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Lookout for Metrics Anomaly Detector (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	if _, err := waitAnomalyDetectorDeleted(ctx, conn, data.ID.ValueString(), r.DeleteTimeout(ctx, data.Timeouts)); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for Lookout for Metrics Anomaly Detector (%s) delete", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func (r *anomalyDetectorResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	r.SetTagsAll(ctx, request, response)
+}
+
+// anomalyDetectorStateFromStatus maps the detector's lifecycle status onto the
+// ACTIVE/INACTIVE desired state exposed by the resource.
+func anomalyDetectorStateFromStatus(status awstypes.AnomalyDetectorStatus) string {
+	switch status {
+	case awstypes.AnomalyDetectorStatusActive,
+		awstypes.AnomalyDetectorStatusActivating,
+		awstypes.AnomalyDetectorStatusLearning,
+		awstypes.AnomalyDetectorStatusBackTestActivating,
+		awstypes.AnomalyDetectorStatusBackTestActive:
+		return anomalyDetectorStateActive
+	default:
+		return anomalyDetectorStateInactive
+	}
+}
+
+func activateAnomalyDetector(ctx context.Context, conn *lookoutmetrics.Client, arn string, timeout time.Duration) error {
+	_, err := conn.ActivateAnomalyDetector(ctx, &lookoutmetrics.ActivateAnomalyDetectorInput{
+		AnomalyDetectorArn: aws.String(arn),
+	})
+
+	if err != nil {
+		return err
+	}
+
+	if _, err := waitAnomalyDetectorActivated(ctx, conn, arn, timeout); err != nil {
+		return fmt.Errorf("waiting for activation: %w", err)
+	}
+
+	return nil
+}
+
+func deactivateAnomalyDetector(ctx context.Context, conn *lookoutmetrics.Client, arn string, timeout time.Duration) error {
+	_, err := conn.DeactivateAnomalyDetector(ctx, &lookoutmetrics.DeactivateAnomalyDetectorInput{
+		AnomalyDetectorArn: aws.String(arn),
+	})
+
+	if err != nil {
+		return err
+	}
+
+	if _, err := waitAnomalyDetectorDeactivated(ctx, conn, arn, timeout); err != nil {
+		return fmt.Errorf("waiting for deactivation: %w", err)
+	}
+
+	return nil
+}
+
+func findAnomalyDetectorByARN(ctx context.Context, conn *lookoutmetrics.Client, arn string) (*lookoutmetrics.DescribeAnomalyDetectorOutput, error) {
+	input := &lookoutmetrics.DescribeAnomalyDetectorInput{
+		AnomalyDetectorArn: aws.String(arn),
+	}
+
+	output, err := conn.DescribeAnomalyDetector(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func statusAnomalyDetector(ctx context.Context, conn *lookoutmetrics.Client, arn string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findAnomalyDetectorByARN(ctx, conn, arn)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.Status), nil
+	}
+}
+
+func waitAnomalyDetectorActivated(ctx context.Context, conn *lookoutmetrics.Client, arn string, timeout time.Duration) (*lookoutmetrics.DescribeAnomalyDetectorOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.AnomalyDetectorStatusActivating, awstypes.AnomalyDetectorStatusBackTestActivating),
+		Target:  enum.Slice(awstypes.AnomalyDetectorStatusActive, awstypes.AnomalyDetectorStatusLearning, awstypes.AnomalyDetectorStatusBackTestActive),
+		Refresh: statusAnomalyDetector(ctx, conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*lookoutmetrics.DescribeAnomalyDetectorOutput); ok {
+		tfresource.SetLastError(err, errors.New(aws.ToString(output.FailureReason)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitAnomalyDetectorDeactivated(ctx context.Context, conn *lookoutmetrics.Client, arn string, timeout time.Duration) (*lookoutmetrics.DescribeAnomalyDetectorOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.AnomalyDetectorStatusDeactivating),
+		Target:  enum.Slice(awstypes.AnomalyDetectorStatusDeactivated, awstypes.AnomalyDetectorStatusInactive),
+		Refresh: statusAnomalyDetector(ctx, conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*lookoutmetrics.DescribeAnomalyDetectorOutput); ok {
+		tfresource.SetLastError(err, errors.New(aws.ToString(output.FailureReason)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitAnomalyDetectorDeleted(ctx context.Context, conn *lookoutmetrics.Client, arn string, timeout time.Duration) (*lookoutmetrics.DescribeAnomalyDetectorOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.AnomalyDetectorStatusDeleting),
+		Target:  []string{},
+		Refresh: statusAnomalyDetector(ctx, conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*lookoutmetrics.DescribeAnomalyDetectorOutput); ok {
+		tfresource.SetLastError(err, errors.New(aws.ToString(output.FailureReason)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+type anomalyDetectorResourceModel struct {
+	AnomalyDetectorARN         types.String                                       `tfsdk:"arn"`
+	AnomalyDetectorDescription types.String                                       `tfsdk:"description"`
+	AnomalyDetectorName        types.String                                       `tfsdk:"name"`
+	Frequency                  fwtypes.StringEnum[awstypes.Frequency]             `tfsdk:"frequency"`
+	ID                         types.String                                       `tfsdk:"id"`
+	KMSKeyARN                  fwtypes.ARN                                        `tfsdk:"kms_key_arn"`
+	State                      types.String                                       `tfsdk:"state"`
+	Status                     fwtypes.StringEnum[awstypes.AnomalyDetectorStatus] `tfsdk:"status"`
+	Tags                       types.Map                                          `tfsdk:"tags"`
+	TagsAll                    types.Map                                          `tfsdk:"tags_all"`
+	Timeouts                   timeouts.Value                                     `tfsdk:"timeouts"`
+}
+
+func (data *anomalyDetectorResourceModel) InitFromID() error {
+	data.AnomalyDetectorARN = data.ID
+
+	return nil
+}
+
+func (data *anomalyDetectorResourceModel) setID() {
+	data.ID = data.AnomalyDetectorARN
+}
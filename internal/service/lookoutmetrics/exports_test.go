@@ -0,0 +1,15 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package lookoutmetrics
+
+// Exports for use in tests only.
+var (
+	ResourceAlert           = newAlertResource
+	ResourceAnomalyDetector = newAnomalyDetectorResource
+	ResourceMetricSet       = newMetricSetResource
+
+	FindAlertByARN           = findAlertByARN
+	FindAnomalyDetectorByARN = findAnomalyDetectorByARN
+	FindMetricSetByARN       = findMetricSetByARN
+)
@@ -0,0 +1,694 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package lookoutmetrics
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lookoutmetrics"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/lookoutmetrics/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_lookoutmetrics_metric_set", name="Metric Set")
+// @Tags(identifierAttribute="arn")
+func newMetricSetResource(context.Context) (resource.ResourceWithConfigure, error) {
+	return &metricSetResource{}, nil
+}
+
+type metricSetResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+}
+
+func (*metricSetResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_lookoutmetrics_metric_set"
+}
+
+func (r *metricSetResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	vpcConfigurationBlock := schema.ListNestedBlock{
+		CustomType: fwtypes.NewListNestedObjectTypeOf[vpcConfigurationModel](ctx),
+		Validators: []validator.List{
+			listvalidator.IsRequired(),
+			listvalidator.SizeAtLeast(1),
+			listvalidator.SizeAtMost(1),
+		},
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"security_group_id_list": schema.ListAttribute{
+					CustomType:  fwtypes.ListOfStringType,
+					ElementType: types.StringType,
+					Required:    true,
+				},
+				"subnet_id_list": schema.ListAttribute{
+					CustomType:  fwtypes.ListOfStringType,
+					ElementType: types.StringType,
+					Required:    true,
+				},
+			},
+		},
+	}
+	databaseSourceAttributes := func(identifierAttribute string) map[string]schema.Attribute {
+		return map[string]schema.Attribute{
+			identifierAttribute: schema.StringAttribute{
+				Required: true,
+			},
+			"database_host": schema.StringAttribute{
+				Required: true,
+			},
+			names.AttrDatabaseName: schema.StringAttribute{
+				Required: true,
+			},
+			"database_port": schema.Int64Attribute{
+				Required: true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, 65535),
+				},
+			},
+			names.AttrRoleARN: schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Required:   true,
+			},
+			"secret_manager_arn": schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Required:   true,
+			},
+			names.AttrTableName: schema.StringAttribute{
+				Required: true,
+			},
+		}
+	}
+	backTestConfigurationBlock := schema.ListNestedBlock{
+		CustomType: fwtypes.NewListNestedObjectTypeOf[backTestConfigurationModel](ctx),
+		Validators: []validator.List{
+			listvalidator.SizeAtMost(1),
+		},
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"run_back_test_mode": schema.BoolAttribute{
+					Required: true,
+				},
+			},
+		},
+	}
+
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"anomaly_detector_arn": schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Required:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			names.AttrDescription: schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(256),
+				},
+			},
+			"dimension_list": schema.ListAttribute{
+				CustomType:  fwtypes.ListOfStringType,
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"frequency": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.Frequency](),
+				Optional:   true,
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 63),
+					stringvalidator.RegexMatches(regexache.MustCompile(`^[0-9A-Za-z_-]+$`), "must contain only alphanumeric characters, hyphens and underscores"),
+				},
+			},
+			"offset": schema.Int64Attribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.Int64{
+					int64validator.Between(0, 432000),
+				},
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+			"timezone": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"dimension_filter": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[metricSetDimensionFilterModel](ctx),
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						names.AttrName: schema.StringAttribute{
+							Required: true,
+						},
+					},
+					Blocks: map[string]schema.Block{
+						names.AttrFilter: schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[filterModel](ctx),
+							Validators: []validator.List{
+								listvalidator.IsRequired(),
+								listvalidator.SizeAtLeast(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"dimension_value": schema.StringAttribute{
+										Required: true,
+									},
+									"filter_operation": schema.StringAttribute{
+										CustomType: fwtypes.StringEnumType[awstypes.FilterOperation](),
+										Required:   true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"metric": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[metricModel](ctx),
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"aggregation_function": schema.StringAttribute{
+							CustomType: fwtypes.StringEnumType[awstypes.AggregationFunction](),
+							Required:   true,
+						},
+						names.AttrMetricName: schema.StringAttribute{
+							Required: true,
+						},
+						names.AttrNamespace: schema.StringAttribute{
+							Optional: true,
+						},
+					},
+				},
+			},
+			"metric_source": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[metricSourceModel](ctx),
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtLeast(1),
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						"cloudwatch_config": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[cloudWatchConfigModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+								listvalidator.ExactlyOneOf(
+									path.MatchRelative().AtParent().AtName("rds_source_config"),
+									path.MatchRelative().AtParent().AtName("redshift_source_config"),
+									path.MatchRelative().AtParent().AtName("s3_source_config"),
+								),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									names.AttrRoleARN: schema.StringAttribute{
+										CustomType: fwtypes.ARNType,
+										Required:   true,
+									},
+								},
+								Blocks: map[string]schema.Block{
+									"back_test_configuration": backTestConfigurationBlock,
+								},
+							},
+						},
+						"rds_source_config": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[rdsSourceConfigModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: databaseSourceAttributes("db_instance_identifier"),
+								Blocks: map[string]schema.Block{
+									names.AttrVPCConfiguration: vpcConfigurationBlock,
+								},
+							},
+						},
+						"redshift_source_config": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[redshiftSourceConfigModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: databaseSourceAttributes(names.AttrClusterIdentifier),
+								Blocks: map[string]schema.Block{
+									names.AttrVPCConfiguration: vpcConfigurationBlock,
+								},
+							},
+						},
+						"s3_source_config": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[s3SourceConfigModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"historical_data_path_list": schema.ListAttribute{
+										CustomType:  fwtypes.ListOfStringType,
+										ElementType: types.StringType,
+										Optional:    true,
+									},
+									names.AttrRoleARN: schema.StringAttribute{
+										CustomType: fwtypes.ARNType,
+										Required:   true,
+									},
+									"templated_path_list": schema.ListAttribute{
+										CustomType:  fwtypes.ListOfStringType,
+										ElementType: types.StringType,
+										Optional:    true,
+									},
+								},
+								Blocks: map[string]schema.Block{
+									"file_format_descriptor": schema.ListNestedBlock{
+										CustomType: fwtypes.NewListNestedObjectTypeOf[fileFormatDescriptorModel](ctx),
+										Validators: []validator.List{
+											listvalidator.IsRequired(),
+											listvalidator.SizeAtLeast(1),
+											listvalidator.SizeAtMost(1),
+										},
+										NestedObject: schema.NestedBlockObject{
+											Blocks: map[string]schema.Block{
+												"csv_format_descriptor": schema.ListNestedBlock{
+													CustomType: fwtypes.NewListNestedObjectTypeOf[csvFormatDescriptorModel](ctx),
+													Validators: []validator.List{
+														listvalidator.SizeAtMost(1),
+														listvalidator.ExactlyOneOf(
+															path.MatchRelative().AtParent().AtName("json_format_descriptor"),
+														),
+													},
+													NestedObject: schema.NestedBlockObject{
+														Attributes: map[string]schema.Attribute{
+															"charset": schema.StringAttribute{
+																Optional: true,
+																Computed: true,
+															},
+															"contains_header": schema.BoolAttribute{
+																Optional: true,
+																Computed: true,
+															},
+															"delimiter": schema.StringAttribute{
+																Optional: true,
+																Computed: true,
+															},
+															"file_compression": schema.StringAttribute{
+																CustomType: fwtypes.StringEnumType[awstypes.CSVFileCompression](),
+																Optional:   true,
+																Computed:   true,
+															},
+															"header_list": schema.ListAttribute{
+																CustomType:  fwtypes.ListOfStringType,
+																ElementType: types.StringType,
+																Optional:    true,
+															},
+															"quote_symbol": schema.StringAttribute{
+																Optional: true,
+																Computed: true,
+															},
+														},
+													},
+												},
+												"json_format_descriptor": schema.ListNestedBlock{
+													CustomType: fwtypes.NewListNestedObjectTypeOf[jsonFormatDescriptorModel](ctx),
+													Validators: []validator.List{
+														listvalidator.SizeAtMost(1),
+													},
+													NestedObject: schema.NestedBlockObject{
+														Attributes: map[string]schema.Attribute{
+															"charset": schema.StringAttribute{
+																Optional: true,
+																Computed: true,
+															},
+															"file_compression": schema.StringAttribute{
+																CustomType: fwtypes.StringEnumType[awstypes.JsonFileCompression](),
+																Optional:   true,
+																Computed:   true,
+															},
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"timestamp_column": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[timestampColumnModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"column_format": schema.StringAttribute{
+							Optional: true,
+						},
+						"column_name": schema.StringAttribute{
+							Optional: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *metricSetResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data metricSetResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().LookoutMetricsClient(ctx)
+
+	input := &lookoutmetrics.CreateMetricSetInput{}
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Additional fields.
+	input.Tags = getTagsIn(ctx)
+
+	output, err := conn.CreateMetricSet(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating Lookout for Metrics Metric Set (%s)", data.MetricSetName.ValueString()), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.MetricSetARN = fwflex.StringToFramework(ctx, output.MetricSetArn)
+	data.setID()
+
+	metricSet, err := findMetricSetByARN(ctx, conn, data.ID.ValueString())
+
+	if err != nil {
+		response.State.SetAttribute(ctx, path.Root(names.AttrID), data.ID) // Set 'id' so as to taint the resource.
+		response.Diagnostics.AddError(fmt.Sprintf("reading Lookout for Metrics Metric Set (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, metricSet, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *metricSetResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data metricSetResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if err := data.InitFromID(); err != nil {
+		response.Diagnostics.AddError("parsing resource ID", err.Error())
+
+		return
+	}
+
+	conn := r.Meta().LookoutMetricsClient(ctx)
+
+	output, err := findMetricSetByARN(ctx, conn, data.ID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Lookout for Metrics Metric Set (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *metricSetResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new metricSetResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().LookoutMetricsClient(ctx)
+
+	if !new.DimensionFilterList.Equal(old.DimensionFilterList) ||
+		!new.DimensionList.Equal(old.DimensionList) ||
+		!new.MetricList.Equal(old.MetricList) ||
+		!new.MetricSetDescription.Equal(old.MetricSetDescription) ||
+		!new.MetricSetFrequency.Equal(old.MetricSetFrequency) ||
+		!new.MetricSource.Equal(old.MetricSource) ||
+		!new.Offset.Equal(old.Offset) ||
+		!new.TimestampColumn.Equal(old.TimestampColumn) {
+		input := &lookoutmetrics.UpdateMetricSetInput{}
+		response.Diagnostics.Append(fwflex.Expand(ctx, new, input)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		input.MetricSetArn = aws.String(new.ID.ValueString())
+
+		_, err := conn.UpdateMetricSet(ctx, input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating Lookout for Metrics Metric Set (%s)", new.ID.ValueString()), err.Error())
+
+			return
+		}
+
+		output, err := findMetricSetByARN(ctx, conn, new.ID.ValueString())
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("reading Lookout for Metrics Metric Set (%s)", new.ID.ValueString()), err.Error())
+
+			return
+		}
+
+		response.Diagnostics.Append(fwflex.Flatten(ctx, output, &new)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *metricSetResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	// Lookout for Metrics has no API to delete an individual metric set.
+	// A metric set is deleted together with its anomaly detector, so this simply removes it from state.
+}
+
+func (r *metricSetResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	r.SetTagsAll(ctx, request, response)
+}
+
+func findMetricSetByARN(ctx context.Context, conn *lookoutmetrics.Client, arn string) (*lookoutmetrics.DescribeMetricSetOutput, error) {
+	input := &lookoutmetrics.DescribeMetricSetInput{
+		MetricSetArn: aws.String(arn),
+	}
+
+	output, err := conn.DescribeMetricSet(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+type metricSetResourceModel struct {
+	AnomalyDetectorARN   fwtypes.ARN                                                    `tfsdk:"anomaly_detector_arn"`
+	DimensionFilterList  fwtypes.ListNestedObjectValueOf[metricSetDimensionFilterModel] `tfsdk:"dimension_filter"`
+	DimensionList        fwtypes.ListValueOf[types.String]                              `tfsdk:"dimension_list"`
+	ID                   types.String                                                   `tfsdk:"id"`
+	MetricList           fwtypes.ListNestedObjectValueOf[metricModel]                   `tfsdk:"metric"`
+	MetricSetARN         types.String                                                   `tfsdk:"arn"`
+	MetricSetDescription types.String                                                   `tfsdk:"description"`
+	MetricSetFrequency   fwtypes.StringEnum[awstypes.Frequency]                         `tfsdk:"frequency"`
+	MetricSetName        types.String                                                   `tfsdk:"name"`
+	MetricSource         fwtypes.ListNestedObjectValueOf[metricSourceModel]             `tfsdk:"metric_source"`
+	Offset               types.Int64                                                    `tfsdk:"offset"`
+	Tags                 types.Map                                                      `tfsdk:"tags"`
+	TagsAll              types.Map                                                      `tfsdk:"tags_all"`
+	TimestampColumn      fwtypes.ListNestedObjectValueOf[timestampColumnModel]          `tfsdk:"timestamp_column"`
+	Timezone             types.String                                                   `tfsdk:"timezone"`
+}
+
+func (data *metricSetResourceModel) InitFromID() error {
+	data.MetricSetARN = data.ID
+
+	return nil
+}
+
+func (data *metricSetResourceModel) setID() {
+	data.ID = data.MetricSetARN
+}
+
+type metricSetDimensionFilterModel struct {
+	FilterList fwtypes.ListNestedObjectValueOf[filterModel] `tfsdk:"filter"`
+	Name       types.String                                 `tfsdk:"name"`
+}
+
+type filterModel struct {
+	DimensionValue  types.String                                 `tfsdk:"dimension_value"`
+	FilterOperation fwtypes.StringEnum[awstypes.FilterOperation] `tfsdk:"filter_operation"`
+}
+
+type metricModel struct {
+	AggregationFunction fwtypes.StringEnum[awstypes.AggregationFunction] `tfsdk:"aggregation_function"`
+	MetricName          types.String                                     `tfsdk:"metric_name"`
+	Namespace           types.String                                     `tfsdk:"namespace"`
+}
+
+type metricSourceModel struct {
+	CloudWatchConfig     fwtypes.ListNestedObjectValueOf[cloudWatchConfigModel]     `tfsdk:"cloudwatch_config"`
+	RDSSourceConfig      fwtypes.ListNestedObjectValueOf[rdsSourceConfigModel]      `tfsdk:"rds_source_config"`
+	RedshiftSourceConfig fwtypes.ListNestedObjectValueOf[redshiftSourceConfigModel] `tfsdk:"redshift_source_config"`
+	S3SourceConfig       fwtypes.ListNestedObjectValueOf[s3SourceConfigModel]       `tfsdk:"s3_source_config"`
+}
+
+type cloudWatchConfigModel struct {
+	BackTestConfiguration fwtypes.ListNestedObjectValueOf[backTestConfigurationModel] `tfsdk:"back_test_configuration"`
+	RoleARN               fwtypes.ARN                                                 `tfsdk:"role_arn"`
+}
+
+type backTestConfigurationModel struct {
+	RunBackTestMode types.Bool `tfsdk:"run_back_test_mode"`
+}
+
+type rdsSourceConfigModel struct {
+	DatabaseHost         types.String                                           `tfsdk:"database_host"`
+	DatabaseName         types.String                                           `tfsdk:"database_name"`
+	DatabasePort         types.Int64                                            `tfsdk:"database_port"`
+	DBInstanceIdentifier types.String                                           `tfsdk:"db_instance_identifier"`
+	RoleARN              fwtypes.ARN                                            `tfsdk:"role_arn"`
+	SecretManagerARN     fwtypes.ARN                                            `tfsdk:"secret_manager_arn"`
+	TableName            types.String                                           `tfsdk:"table_name"`
+	VPCConfiguration     fwtypes.ListNestedObjectValueOf[vpcConfigurationModel] `tfsdk:"vpc_configuration"`
+}
+
+type redshiftSourceConfigModel struct {
+	ClusterIdentifier types.String                                           `tfsdk:"cluster_identifier"`
+	DatabaseHost      types.String                                           `tfsdk:"database_host"`
+	DatabaseName      types.String                                           `tfsdk:"database_name"`
+	DatabasePort      types.Int64                                            `tfsdk:"database_port"`
+	RoleARN           fwtypes.ARN                                            `tfsdk:"role_arn"`
+	SecretManagerARN  fwtypes.ARN                                            `tfsdk:"secret_manager_arn"`
+	TableName         types.String                                           `tfsdk:"table_name"`
+	VPCConfiguration  fwtypes.ListNestedObjectValueOf[vpcConfigurationModel] `tfsdk:"vpc_configuration"`
+}
+
+type vpcConfigurationModel struct {
+	SecurityGroupIDList fwtypes.ListValueOf[types.String] `tfsdk:"security_group_id_list"`
+	SubnetIDList        fwtypes.ListValueOf[types.String] `tfsdk:"subnet_id_list"`
+}
+
+type s3SourceConfigModel struct {
+	FileFormatDescriptor   fwtypes.ListNestedObjectValueOf[fileFormatDescriptorModel] `tfsdk:"file_format_descriptor"`
+	HistoricalDataPathList fwtypes.ListValueOf[types.String]                          `tfsdk:"historical_data_path_list"`
+	RoleARN                fwtypes.ARN                                                `tfsdk:"role_arn"`
+	TemplatedPathList      fwtypes.ListValueOf[types.String]                          `tfsdk:"templated_path_list"`
+}
+
+type fileFormatDescriptorModel struct {
+	CSVFormatDescriptor  fwtypes.ListNestedObjectValueOf[csvFormatDescriptorModel]  `tfsdk:"csv_format_descriptor"`
+	JSONFormatDescriptor fwtypes.ListNestedObjectValueOf[jsonFormatDescriptorModel] `tfsdk:"json_format_descriptor"`
+}
+
+type csvFormatDescriptorModel struct {
+	Charset         types.String                                    `tfsdk:"charset"`
+	ContainsHeader  types.Bool                                      `tfsdk:"contains_header"`
+	Delimiter       types.String                                    `tfsdk:"delimiter"`
+	FileCompression fwtypes.StringEnum[awstypes.CSVFileCompression] `tfsdk:"file_compression"`
+	HeaderList      fwtypes.ListValueOf[types.String]               `tfsdk:"header_list"`
+	QuoteSymbol     types.String                                    `tfsdk:"quote_symbol"`
+}
+
+type jsonFormatDescriptorModel struct {
+	Charset         types.String                                     `tfsdk:"charset"`
+	FileCompression fwtypes.StringEnum[awstypes.JsonFileCompression] `tfsdk:"file_compression"`
+}
+
+type timestampColumnModel struct {
+	ColumnFormat types.String `tfsdk:"column_format"`
+	ColumnName   types.String `tfsdk:"column_name"`
+}
@@ -0,0 +1,205 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package lookoutmetrics_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/service/lookoutmetrics"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tflookoutmetrics "github.com/hashicorp/terraform-provider-aws/internal/service/lookoutmetrics"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccLookoutMetricsMetricSet_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v lookoutmetrics.DescribeMetricSetOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_lookoutmetrics_metric_set.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.LookoutMetricsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckMetricSetDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMetricSetConfig_basic(rName, "SUM"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckMetricSetExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttrPair(resourceName, "anomaly_detector_arn", "aws_lookoutmetrics_anomaly_detector.test", names.AttrARN),
+					acctest.MatchResourceAttrRegionalARN(resourceName, names.AttrARN, "lookoutmetrics", regexache.MustCompile(`MetricSet/.+$`)),
+					resource.TestCheckResourceAttr(resourceName, "dimension_list.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "dimension_list.0", "region"),
+					resource.TestCheckResourceAttr(resourceName, "frequency", "PT1H"),
+					resource.TestCheckResourceAttr(resourceName, "metric.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "metric.0.aggregation_function", "SUM"),
+					resource.TestCheckResourceAttr(resourceName, "metric.0.metric_name", "revenue"),
+					resource.TestCheckResourceAttr(resourceName, "metric_source.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "metric_source.0.s3_source_config.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "metric_source.0.s3_source_config.0.file_format_descriptor.0.csv_format_descriptor.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+					resource.TestCheckResourceAttr(resourceName, "timestamp_column.0.column_name", "timestamp"),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct0),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccMetricSetConfig_basic(rName, "AVG"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckMetricSetExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "metric.0.aggregation_function", "AVG"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckMetricSetExists(ctx context.Context, n string, v *lookoutmetrics.DescribeMetricSetOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).LookoutMetricsClient(ctx)
+
+		output, err := tflookoutmetrics.FindMetricSetByARN(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckMetricSetDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).LookoutMetricsClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_lookoutmetrics_metric_set" {
+				continue
+			}
+
+			_, err := tflookoutmetrics.FindMetricSetByARN(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Lookout for Metrics Metric Set %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccMetricSetConfig_base(rName string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Effect = "Allow"
+      Principal = {
+        Service = "lookoutmetrics.${data.aws_partition.current.dns_suffix}"
+      }
+      Action = "sts:AssumeRole"
+    }]
+  })
+}
+
+resource "aws_iam_role_policy" "test" {
+  name = %[1]q
+  role = aws_iam_role.test.id
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Effect = "Allow"
+      Action = [
+        "s3:GetObject",
+        "s3:ListBucket",
+      ]
+      Resource = [
+        aws_s3_bucket.test.arn,
+        "${aws_s3_bucket.test.arn}/*",
+      ]
+    }]
+  })
+}
+
+resource "aws_lookoutmetrics_anomaly_detector" "test" {
+  name      = %[1]q
+  frequency = "PT1H"
+}
+`, rName)
+}
+
+func testAccMetricSetConfig_basic(rName, aggregationFunction string) string {
+	return acctest.ConfigCompose(testAccMetricSetConfig_base(rName), fmt.Sprintf(`
+resource "aws_lookoutmetrics_metric_set" "test" {
+  name                 = %[1]q
+  anomaly_detector_arn = aws_lookoutmetrics_anomaly_detector.test.arn
+  frequency            = "PT1H"
+  dimension_list       = ["region"]
+
+  metric {
+    aggregation_function = %[2]q
+    metric_name          = "revenue"
+  }
+
+  metric_source {
+    s3_source_config {
+      role_arn            = aws_iam_role.test.arn
+      templated_path_list = ["s3://${aws_s3_bucket.test.bucket}/data/{{yyyyMMdd}}/{{HHmm}}"]
+
+      file_format_descriptor {
+        csv_format_descriptor {
+          contains_header  = true
+          delimiter        = ","
+          file_compression = "NONE"
+        }
+      }
+    }
+  }
+
+  timestamp_column {
+    column_format = "yyyy-MM-dd HH:mm:ss"
+    column_name   = "timestamp"
+  }
+
+  depends_on = [aws_iam_role_policy.test]
+}
+`, rName, aggregationFunction))
+}
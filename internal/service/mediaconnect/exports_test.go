@@ -0,0 +1,17 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mediaconnect
+
+// Exports for use in tests only.
+var (
+	ResourceFlow            = newFlowResource
+	ResourceFlowEntitlement = newFlowEntitlementResource
+	ResourceFlowOutput      = newFlowOutputResource
+	ResourceFlowSource      = newFlowSourceResource
+
+	FindFlowByARN                   = findFlowByARN
+	FindFlowEntitlementByTwoPartKey = findFlowEntitlementByTwoPartKey
+	FindFlowOutputByTwoPartKey      = findFlowOutputByTwoPartKey
+	FindFlowSourceByTwoPartKey      = findFlowSourceByTwoPartKey
+)
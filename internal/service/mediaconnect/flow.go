@@ -0,0 +1,1401 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mediaconnect
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/mediaconnect"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/mediaconnect/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	flowUpdateTimeout = 10 * time.Minute
+)
+
+// @FrameworkResource("aws_mediaconnect_flow", name="Flow")
+// @Tags(identifierAttribute="arn")
+func newFlowResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &flowResource{}
+
+	r.SetDefaultCreateTimeout(30 * time.Minute)
+	r.SetDefaultUpdateTimeout(30 * time.Minute)
+	r.SetDefaultDeleteTimeout(30 * time.Minute)
+
+	return r, nil
+}
+
+type flowResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+	framework.WithTimeouts
+}
+
+func (*flowResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_mediaconnect_flow"
+}
+
+func (r *flowResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			names.AttrAvailabilityZone: schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"egress_ip": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"start_flow": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+			names.AttrStatus: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.Status](),
+				Computed:   true,
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+		},
+		Blocks: map[string]schema.Block{
+			"maintenance": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[maintenanceModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"maintenance_day": schema.StringAttribute{
+							CustomType: fwtypes.StringEnumType[awstypes.MaintenanceDay](),
+							Required:   true,
+						},
+						"maintenance_deadline": schema.StringAttribute{
+							Computed: true,
+						},
+						"maintenance_scheduled_date": schema.StringAttribute{
+							Computed: true,
+						},
+						"maintenance_start_hour": schema.StringAttribute{
+							Required: true,
+						},
+					},
+				},
+			},
+			"media_stream": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[mediaStreamModel](ctx),
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"clock_rate": schema.Int64Attribute{
+							Optional: true,
+							Computed: true,
+						},
+						names.AttrDescription: schema.StringAttribute{
+							Optional: true,
+						},
+						"fmt": schema.Int64Attribute{
+							Computed: true,
+						},
+						"media_stream_id": schema.Int64Attribute{
+							Required: true,
+						},
+						"media_stream_name": schema.StringAttribute{
+							Required: true,
+						},
+						"media_stream_type": schema.StringAttribute{
+							CustomType: fwtypes.StringEnumType[awstypes.MediaStreamType](),
+							Required:   true,
+						},
+						"video_format": schema.StringAttribute{
+							Optional: true,
+							Computed: true,
+						},
+					},
+					Blocks: map[string]schema.Block{
+						names.AttrAttributes: schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[mediaStreamAttributesModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"lang": schema.StringAttribute{
+										Optional: true,
+									},
+								},
+								Blocks: map[string]schema.Block{
+									"fmtp": schema.ListNestedBlock{
+										CustomType: fwtypes.NewListNestedObjectTypeOf[fmtpModel](ctx),
+										Validators: []validator.List{
+											listvalidator.SizeAtMost(1),
+										},
+										NestedObject: schema.NestedBlockObject{
+											Attributes: map[string]schema.Attribute{
+												"channel_order": schema.StringAttribute{
+													Optional: true,
+												},
+												"colorimetry": schema.StringAttribute{
+													CustomType: fwtypes.StringEnumType[awstypes.Colorimetry](),
+													Optional:   true,
+												},
+												"exact_framerate": schema.StringAttribute{
+													Optional: true,
+												},
+												"par": schema.StringAttribute{
+													Optional: true,
+												},
+												"range": schema.StringAttribute{
+													CustomType: fwtypes.StringEnumType[awstypes.Range](),
+													Optional:   true,
+												},
+												"scan_mode": schema.StringAttribute{
+													CustomType: fwtypes.StringEnumType[awstypes.ScanMode](),
+													Optional:   true,
+												},
+												"tcs": schema.StringAttribute{
+													CustomType: fwtypes.StringEnumType[awstypes.Tcs](),
+													Optional:   true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			names.AttrSource: schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[sourceModel](ctx),
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: sourceAttributes(),
+					Blocks:     sourceBlocks(ctx),
+				},
+			},
+			"source_failover_config": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[failoverConfigModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"failover_mode": schema.StringAttribute{
+							CustomType: fwtypes.StringEnumType[awstypes.FailoverMode](),
+							Optional:   true,
+							Computed:   true,
+						},
+						"recovery_window": schema.Int64Attribute{
+							Optional: true,
+							Computed: true,
+						},
+						names.AttrState: schema.StringAttribute{
+							CustomType: fwtypes.StringEnumType[awstypes.State](),
+							Optional:   true,
+							Computed:   true,
+						},
+					},
+					Blocks: map[string]schema.Block{
+						"source_priority": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[sourcePriorityModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"primary_source": schema.StringAttribute{
+										Optional: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
+			"vpc_interface": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[vpcInterfaceModel](ctx),
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						names.AttrName: schema.StringAttribute{
+							Required: true,
+						},
+						"network_interface_ids": schema.SetAttribute{
+							CustomType:  fwtypes.SetOfStringType,
+							ElementType: types.StringType,
+							Computed:    true,
+							PlanModifiers: []planmodifier.Set{
+								setplanmodifier.UseStateForUnknown(),
+							},
+						},
+						"network_interface_type": schema.StringAttribute{
+							CustomType: fwtypes.StringEnumType[awstypes.NetworkInterfaceType](),
+							Optional:   true,
+							Computed:   true,
+							PlanModifiers: []planmodifier.String{
+								stringplanmodifier.UseStateForUnknown(),
+							},
+						},
+						names.AttrRoleARN: schema.StringAttribute{
+							CustomType: fwtypes.ARNType,
+							Required:   true,
+						},
+						names.AttrSecurityGroupIDs: schema.SetAttribute{
+							CustomType:  fwtypes.SetOfStringType,
+							ElementType: types.StringType,
+							Required:    true,
+						},
+						names.AttrSubnetID: schema.StringAttribute{
+							Required: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *flowResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data flowResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().MediaConnectClient(ctx)
+
+	name := data.Name.ValueString()
+	input := &mediaconnect.CreateFlowInput{}
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	output, err := conn.CreateFlow(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating MediaConnect Flow (%s)", name), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	arn := aws.ToString(output.Flow.FlowArn)
+	data.FlowARN = types.StringValue(arn)
+	data.setID()
+
+	timeout := r.CreateTimeout(ctx, data.Timeouts)
+	if _, err := waitFlowCreated(ctx, conn, arn, timeout); err != nil {
+		response.State.SetAttribute(ctx, path.Root(names.AttrID), data.ID) // Set 'id' so as to taint the resource.
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for MediaConnect Flow (%s) create", arn), err.Error())
+
+		return
+	}
+
+	// Flows can't be tagged on creation.
+	if err := createTags(ctx, conn, arn, getTagsIn(ctx)); err != nil {
+		response.State.SetAttribute(ctx, path.Root(names.AttrID), data.ID) // Set 'id' so as to taint the resource.
+		response.Diagnostics.AddError(fmt.Sprintf("setting MediaConnect Flow (%s) tags", arn), err.Error())
+
+		return
+	}
+
+	if data.StartFlow.ValueBool() {
+		if err := startFlow(ctx, conn, arn, timeout); err != nil {
+			response.State.SetAttribute(ctx, path.Root(names.AttrID), data.ID) // Set 'id' so as to taint the resource.
+			response.Diagnostics.AddError(fmt.Sprintf("creating MediaConnect Flow (%s)", arn), err.Error())
+
+			return
+		}
+	}
+
+	flow, err := findFlowByARN(ctx, conn, arn)
+
+	if err != nil {
+		response.State.SetAttribute(ctx, path.Root(names.AttrID), data.ID) // Set 'id' so as to taint the resource.
+		response.Diagnostics.AddError(fmt.Sprintf("reading MediaConnect Flow (%s)", arn), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(data.flatten(ctx, flow, false)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *flowResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data flowResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if err := data.InitFromID(); err != nil {
+		response.Diagnostics.AddError("parsing resource ID", err.Error())
+
+		return
+	}
+
+	conn := r.Meta().MediaConnectClient(ctx)
+
+	flow, err := findFlowByARN(ctx, conn, data.ID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading MediaConnect Flow (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	// A null name means the resource is being imported.
+	importing := data.Name.IsNull()
+
+	response.Diagnostics.Append(data.flatten(ctx, flow, importing)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if importing {
+		data.StartFlow = types.BoolValue(flow.Status == awstypes.StatusActive)
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *flowResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new flowResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().MediaConnectClient(ctx)
+
+	arn := new.ID.ValueString()
+	timeout := r.UpdateTimeout(ctx, new.Timeouts)
+
+	if !new.Maintenance.Equal(old.Maintenance) || !new.SourceFailoverConfig.Equal(old.SourceFailoverConfig) {
+		input := &mediaconnect.UpdateFlowInput{
+			FlowArn: aws.String(arn),
+		}
+
+		if !new.Maintenance.Equal(old.Maintenance) {
+			input.Maintenance = &awstypes.UpdateMaintenance{}
+			response.Diagnostics.Append(fwflex.Expand(ctx, new.Maintenance, input.Maintenance)...)
+			if response.Diagnostics.HasError() {
+				return
+			}
+		}
+
+		if !new.SourceFailoverConfig.Equal(old.SourceFailoverConfig) {
+			input.SourceFailoverConfig = &awstypes.UpdateFailoverConfig{
+				State: awstypes.StateDisabled,
+			}
+			if !new.SourceFailoverConfig.IsNull() && len(new.SourceFailoverConfig.Elements()) > 0 {
+				response.Diagnostics.Append(fwflex.Expand(ctx, new.SourceFailoverConfig, input.SourceFailoverConfig)...)
+				if response.Diagnostics.HasError() {
+					return
+				}
+			}
+		}
+
+		_, err := conn.UpdateFlow(ctx, input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating MediaConnect Flow (%s)", arn), err.Error())
+
+			return
+		}
+
+		if _, err := waitFlowUpdated(ctx, conn, arn, timeout); err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("waiting for MediaConnect Flow (%s) update", arn), err.Error())
+
+			return
+		}
+	}
+
+	if !new.MediaStreams.Equal(old.MediaStreams) {
+		response.Diagnostics.Append(updateFlowMediaStreams(ctx, conn, arn, old.MediaStreams, new.MediaStreams)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	if !new.Sources.Equal(old.Sources) {
+		response.Diagnostics.Append(updateFlowSources(ctx, conn, arn, old.Sources, new.Sources)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	if !new.MediaStreams.Equal(old.MediaStreams) || !new.Sources.Equal(old.Sources) {
+		if _, err := waitFlowUpdated(ctx, conn, arn, timeout); err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("waiting for MediaConnect Flow (%s) update", arn), err.Error())
+
+			return
+		}
+	}
+
+	if !new.StartFlow.Equal(old.StartFlow) {
+		flow, err := findFlowByARN(ctx, conn, arn)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("reading MediaConnect Flow (%s)", arn), err.Error())
+
+			return
+		}
+
+		switch new.StartFlow.ValueBool() {
+		case true:
+			if flow.Status == awstypes.StatusStandby {
+				if err := startFlow(ctx, conn, arn, timeout); err != nil {
+					response.Diagnostics.AddError(fmt.Sprintf("updating MediaConnect Flow (%s)", arn), err.Error())
+
+					return
+				}
+			}
+		default:
+			if flow.Status == awstypes.StatusActive {
+				if err := stopFlow(ctx, conn, arn, timeout); err != nil {
+					response.Diagnostics.AddError(fmt.Sprintf("updating MediaConnect Flow (%s)", arn), err.Error())
+
+					return
+				}
+			}
+		}
+	}
+
+	flow, err := findFlowByARN(ctx, conn, arn)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading MediaConnect Flow (%s)", arn), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(new.flatten(ctx, flow, false)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *flowResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data flowResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().MediaConnectClient(ctx)
+
+	arn := data.ID.ValueString()
+	timeout := r.DeleteTimeout(ctx, data.Timeouts)
+
+	flow, err := findFlowByARN(ctx, conn, arn)
+
+	if tfresource.NotFound(err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading MediaConnect Flow (%s)", arn), err.Error())
+
+		return
+	}
+
+	// Active flows must be stopped before they can be deleted.
+	if flow.Status == awstypes.StatusActive {
+		if err := stopFlow(ctx, conn, arn, timeout); err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("deleting MediaConnect Flow (%s)", arn), err.Error())
+
+			return
+		}
+	}
+
+	_, err = conn.DeleteFlow(ctx, &mediaconnect.DeleteFlowInput{
+		FlowArn: aws.String(arn),
+	})
+
+	if errs.IsA[*awstypes.NotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting MediaConnect Flow (%s)", arn), err.Error())
+
+		return
+	}
+
+	if _, err := waitFlowDeleted(ctx, conn, arn, timeout); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for MediaConnect Flow (%s) delete", arn), err.Error())
+
+		return
+	}
+}
+
+func (r *flowResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	r.SetTagsAll(ctx, request, response)
+}
+
+func updateFlowMediaStreams(ctx context.Context, conn *mediaconnect.Client, arn string, o, n fwtypes.ListNestedObjectValueOf[mediaStreamModel]) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	oldStreams, d := o.ToSlice(ctx)
+	diags.Append(d...)
+	newStreams, d := n.ToSlice(ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	oldByName := make(map[string]*mediaStreamModel, len(oldStreams))
+	for _, v := range oldStreams {
+		oldByName[v.MediaStreamName.ValueString()] = v
+	}
+
+	var add []awstypes.AddMediaStreamRequest
+	for _, v := range newStreams {
+		name := v.MediaStreamName.ValueString()
+
+		if old, ok := oldByName[name]; ok {
+			delete(oldByName, name)
+
+			if mediaStreamConfigEqual(old, v) {
+				continue
+			}
+
+			input := &mediaconnect.UpdateFlowMediaStreamInput{}
+			diags.Append(fwflex.Expand(ctx, v, input)...)
+			if diags.HasError() {
+				return diags
+			}
+
+			input.FlowArn = aws.String(arn)
+
+			if _, err := conn.UpdateFlowMediaStream(ctx, input); err != nil {
+				diags.AddError(fmt.Sprintf("updating MediaConnect Flow (%s) media stream (%s)", arn, name), err.Error())
+
+				return diags
+			}
+
+			continue
+		}
+
+		var apiObject awstypes.AddMediaStreamRequest
+		diags.Append(fwflex.Expand(ctx, v, &apiObject)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		add = append(add, apiObject)
+	}
+
+	for name := range oldByName {
+		input := &mediaconnect.RemoveFlowMediaStreamInput{
+			FlowArn:         aws.String(arn),
+			MediaStreamName: aws.String(name),
+		}
+
+		_, err := conn.RemoveFlowMediaStream(ctx, input)
+
+		if errs.IsA[*awstypes.NotFoundException](err) {
+			continue
+		}
+
+		if err != nil {
+			diags.AddError(fmt.Sprintf("removing MediaConnect Flow (%s) media stream (%s)", arn, name), err.Error())
+
+			return diags
+		}
+	}
+
+	if len(add) > 0 {
+		input := &mediaconnect.AddFlowMediaStreamsInput{
+			FlowArn:      aws.String(arn),
+			MediaStreams: add,
+		}
+
+		if _, err := conn.AddFlowMediaStreams(ctx, input); err != nil {
+			diags.AddError(fmt.Sprintf("adding MediaConnect Flow (%s) media streams", arn), err.Error())
+
+			return diags
+		}
+	}
+
+	return diags
+}
+
+// mediaStreamConfigEqual compares the configurable arguments of two media streams.
+func mediaStreamConfigEqual(o, n *mediaStreamModel) bool {
+	return o.Attributes.Equal(n.Attributes) &&
+		(n.ClockRate.IsUnknown() || o.ClockRate.Equal(n.ClockRate)) &&
+		o.Description.Equal(n.Description) &&
+		o.MediaStreamType.Equal(n.MediaStreamType) &&
+		(n.VideoFormat.IsUnknown() || o.VideoFormat.Equal(n.VideoFormat))
+}
+
+func updateFlowSources(ctx context.Context, conn *mediaconnect.Client, arn string, o, n fwtypes.ListNestedObjectValueOf[sourceModel]) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	oldSources, d := o.ToSlice(ctx)
+	diags.Append(d...)
+	newSources, d := n.ToSlice(ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	oldByName := make(map[string]*sourceModel, len(oldSources))
+	for _, v := range oldSources {
+		oldByName[v.Name.ValueString()] = v
+	}
+
+	var add []awstypes.SetSourceRequest
+	for _, v := range newSources {
+		name := v.Name.ValueString()
+
+		if old, ok := oldByName[name]; ok {
+			delete(oldByName, name)
+
+			if sourceConfigEqual(old, v) {
+				continue
+			}
+
+			input := &mediaconnect.UpdateFlowSourceInput{}
+			diags.Append(fwflex.Expand(ctx, v, input)...)
+			if diags.HasError() {
+				return diags
+			}
+
+			input.FlowArn = aws.String(arn)
+			input.SourceArn = fwflex.StringFromFramework(ctx, old.SourceARN)
+
+			if _, err := conn.UpdateFlowSource(ctx, input); err != nil {
+				diags.AddError(fmt.Sprintf("updating MediaConnect Flow (%s) source (%s)", arn, name), err.Error())
+
+				return diags
+			}
+
+			continue
+		}
+
+		var apiObject awstypes.SetSourceRequest
+		diags.Append(fwflex.Expand(ctx, v, &apiObject)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		add = append(add, apiObject)
+	}
+
+	for _, v := range oldByName {
+		input := &mediaconnect.RemoveFlowSourceInput{
+			FlowArn:   aws.String(arn),
+			SourceArn: fwflex.StringFromFramework(ctx, v.SourceARN),
+		}
+
+		_, err := conn.RemoveFlowSource(ctx, input)
+
+		if errs.IsA[*awstypes.NotFoundException](err) {
+			continue
+		}
+
+		if err != nil {
+			diags.AddError(fmt.Sprintf("removing MediaConnect Flow (%s) source (%s)", arn, v.Name.ValueString()), err.Error())
+
+			return diags
+		}
+	}
+
+	if len(add) > 0 {
+		input := &mediaconnect.AddFlowSourcesInput{
+			FlowArn: aws.String(arn),
+			Sources: add,
+		}
+
+		if _, err := conn.AddFlowSources(ctx, input); err != nil {
+			diags.AddError(fmt.Sprintf("adding MediaConnect Flow (%s) sources", arn), err.Error())
+
+			return diags
+		}
+	}
+
+	return diags
+}
+
+// sourceConfigEqual compares the configurable arguments of two sources.
+func sourceConfigEqual(o, n *sourceModel) bool {
+	return o.Decryption.Equal(n.Decryption) &&
+		o.Description.Equal(n.Description) &&
+		o.EntitlementARN.Equal(n.EntitlementARN) &&
+		o.GatewayBridgeSource.Equal(n.GatewayBridgeSource) &&
+		(n.IngestPort.IsUnknown() || o.IngestPort.Equal(n.IngestPort)) &&
+		(n.MaxBitrate.IsUnknown() || o.MaxBitrate.Equal(n.MaxBitrate)) &&
+		(n.MaxLatency.IsUnknown() || o.MaxLatency.Equal(n.MaxLatency)) &&
+		(n.MaxSyncBuffer.IsUnknown() || o.MaxSyncBuffer.Equal(n.MaxSyncBuffer)) &&
+		o.MediaStreamSourceConfigurations.Equal(n.MediaStreamSourceConfigurations) &&
+		(n.MinLatency.IsUnknown() || o.MinLatency.Equal(n.MinLatency)) &&
+		o.Protocol.Equal(n.Protocol) &&
+		o.SenderControlPort.Equal(n.SenderControlPort) &&
+		o.SenderIPAddress.Equal(n.SenderIPAddress) &&
+		o.SourceListenerAddress.Equal(n.SourceListenerAddress) &&
+		o.SourceListenerPort.Equal(n.SourceListenerPort) &&
+		o.StreamID.Equal(n.StreamID) &&
+		o.VPCInterfaceName.Equal(n.VPCInterfaceName) &&
+		o.WhitelistCIDR.Equal(n.WhitelistCIDR)
+}
+
+func startFlow(ctx context.Context, conn *mediaconnect.Client, arn string, timeout time.Duration) error {
+	_, err := conn.StartFlow(ctx, &mediaconnect.StartFlowInput{
+		FlowArn: aws.String(arn),
+	})
+
+	if err != nil {
+		return fmt.Errorf("starting MediaConnect Flow (%s): %w", arn, err)
+	}
+
+	if _, err := waitFlowStarted(ctx, conn, arn, timeout); err != nil {
+		return fmt.Errorf("waiting for MediaConnect Flow (%s) start: %w", arn, err)
+	}
+
+	return nil
+}
+
+func stopFlow(ctx context.Context, conn *mediaconnect.Client, arn string, timeout time.Duration) error {
+	_, err := conn.StopFlow(ctx, &mediaconnect.StopFlowInput{
+		FlowArn: aws.String(arn),
+	})
+
+	if err != nil {
+		return fmt.Errorf("stopping MediaConnect Flow (%s): %w", arn, err)
+	}
+
+	if _, err := waitFlowStopped(ctx, conn, arn, timeout); err != nil {
+		return fmt.Errorf("waiting for MediaConnect Flow (%s) stop: %w", arn, err)
+	}
+
+	return nil
+}
+
+func findFlowByARN(ctx context.Context, conn *mediaconnect.Client, arn string) (*awstypes.Flow, error) {
+	input := &mediaconnect.DescribeFlowInput{
+		FlowArn: aws.String(arn),
+	}
+
+	output, err := conn.DescribeFlow(ctx, input)
+
+	if errs.IsA[*awstypes.NotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Flow == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Flow, nil
+}
+
+func statusFlow(ctx context.Context, conn *mediaconnect.Client, arn string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findFlowByARN(ctx, conn, arn)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.Status), nil
+	}
+}
+
+func waitFlowCreated(ctx context.Context, conn *mediaconnect.Client, arn string, timeout time.Duration) (*awstypes.Flow, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.StatusUpdating),
+		Target:  enum.Slice(awstypes.StatusStandby),
+		Refresh: statusFlow(ctx, conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.Flow); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitFlowUpdated(ctx context.Context, conn *mediaconnect.Client, arn string, timeout time.Duration) (*awstypes.Flow, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.StatusUpdating),
+		Target:  enum.Slice(awstypes.StatusStandby, awstypes.StatusActive),
+		Refresh: statusFlow(ctx, conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.Flow); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitFlowStarted(ctx context.Context, conn *mediaconnect.Client, arn string, timeout time.Duration) (*awstypes.Flow, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.StatusStandby, awstypes.StatusStarting, awstypes.StatusUpdating),
+		Target:  enum.Slice(awstypes.StatusActive),
+		Refresh: statusFlow(ctx, conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.Flow); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitFlowStopped(ctx context.Context, conn *mediaconnect.Client, arn string, timeout time.Duration) (*awstypes.Flow, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.StatusActive, awstypes.StatusStopping, awstypes.StatusUpdating),
+		Target:  enum.Slice(awstypes.StatusStandby),
+		Refresh: statusFlow(ctx, conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.Flow); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitFlowDeleted(ctx context.Context, conn *mediaconnect.Client, arn string, timeout time.Duration) (*awstypes.Flow, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.StatusDeleting, awstypes.StatusStandby),
+		Target:  []string{},
+		Refresh: statusFlow(ctx, conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.Flow); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+type flowResourceModel struct {
+	AvailabilityZone     types.String                                         `tfsdk:"availability_zone"`
+	EgressIP             types.String                                         `tfsdk:"egress_ip"`
+	FlowARN              types.String                                         `tfsdk:"arn"`
+	ID                   types.String                                         `tfsdk:"id"`
+	Maintenance          fwtypes.ListNestedObjectValueOf[maintenanceModel]    `tfsdk:"maintenance"`
+	MediaStreams         fwtypes.ListNestedObjectValueOf[mediaStreamModel]    `tfsdk:"media_stream"`
+	Name                 types.String                                         `tfsdk:"name"`
+	SourceFailoverConfig fwtypes.ListNestedObjectValueOf[failoverConfigModel] `tfsdk:"source_failover_config"`
+	Sources              fwtypes.ListNestedObjectValueOf[sourceModel]         `tfsdk:"source"`
+	StartFlow            types.Bool                                           `tfsdk:"start_flow"`
+	Status               fwtypes.StringEnum[awstypes.Status]                  `tfsdk:"status"`
+	Tags                 types.Map                                            `tfsdk:"tags"`
+	TagsAll              types.Map                                            `tfsdk:"tags_all"`
+	Timeouts             timeouts.Value                                       `tfsdk:"timeouts"`
+	VPCInterfaces        fwtypes.ListNestedObjectValueOf[vpcInterfaceModel]   `tfsdk:"vpc_interface"`
+}
+
+func (data *flowResourceModel) InitFromID() error {
+	data.FlowARN = data.ID
+
+	return nil
+}
+
+func (data *flowResourceModel) setID() {
+	data.ID = data.FlowARN
+}
+
+// flatten copies a flow's attributes into the model.
+// Sources added by aws_mediaconnect_flow_source are not tracked here, and
+// service-assigned maintenance and failover settings are only tracked when configured.
+func (data *flowResourceModel) flatten(ctx context.Context, flow *awstypes.Flow, importing bool) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	priorSources, d := data.Sources.ToSlice(ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+	maintenance, sourceFailoverConfig := data.Maintenance, data.SourceFailoverConfig
+
+	diags.Append(fwflex.Flatten(ctx, flow, data)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	sources, d := flattenSources(ctx, flow.Sources)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	if !importing {
+		byName := make(map[string]*sourceModel, len(sources))
+		for _, v := range sources {
+			byName[v.Name.ValueString()] = v
+		}
+
+		sources = nil
+		for _, v := range priorSources {
+			if v, ok := byName[v.Name.ValueString()]; ok {
+				sources = append(sources, v)
+			}
+		}
+
+		if len(maintenance.Elements()) == 0 {
+			data.Maintenance = maintenance
+		}
+		if len(sourceFailoverConfig.Elements()) == 0 {
+			data.SourceFailoverConfig = sourceFailoverConfig
+		}
+	}
+
+	data.Sources, d = fwtypes.NewListNestedObjectValueOfSlice(ctx, sources)
+	diags.Append(d...)
+
+	return diags
+}
+
+type maintenanceModel struct {
+	MaintenanceDay           fwtypes.StringEnum[awstypes.MaintenanceDay] `tfsdk:"maintenance_day"`
+	MaintenanceDeadline      types.String                                `tfsdk:"maintenance_deadline"`
+	MaintenanceScheduledDate types.String                                `tfsdk:"maintenance_scheduled_date"`
+	MaintenanceStartHour     types.String                                `tfsdk:"maintenance_start_hour"`
+}
+
+type mediaStreamModel struct {
+	Attributes      fwtypes.ListNestedObjectValueOf[mediaStreamAttributesModel] `tfsdk:"attributes"`
+	ClockRate       types.Int64                                                 `tfsdk:"clock_rate"`
+	Description     types.String                                                `tfsdk:"description"`
+	Fmt             types.Int64                                                 `tfsdk:"fmt"`
+	MediaStreamID   types.Int64                                                 `tfsdk:"media_stream_id"`
+	MediaStreamName types.String                                                `tfsdk:"media_stream_name"`
+	MediaStreamType fwtypes.StringEnum[awstypes.MediaStreamType]                `tfsdk:"media_stream_type"`
+	VideoFormat     types.String                                                `tfsdk:"video_format"`
+}
+
+type mediaStreamAttributesModel struct {
+	Fmtp fwtypes.ListNestedObjectValueOf[fmtpModel] `tfsdk:"fmtp"`
+	Lang types.String                               `tfsdk:"lang"`
+}
+
+type fmtpModel struct {
+	ChannelOrder   types.String                             `tfsdk:"channel_order"`
+	Colorimetry    fwtypes.StringEnum[awstypes.Colorimetry] `tfsdk:"colorimetry"`
+	ExactFramerate types.String                             `tfsdk:"exact_framerate"`
+	Par            types.String                             `tfsdk:"par"`
+	Range          fwtypes.StringEnum[awstypes.Range]       `tfsdk:"range"`
+	ScanMode       fwtypes.StringEnum[awstypes.ScanMode]    `tfsdk:"scan_mode"`
+	Tcs            fwtypes.StringEnum[awstypes.Tcs]         `tfsdk:"tcs"`
+}
+
+type failoverConfigModel struct {
+	FailoverMode   fwtypes.StringEnum[awstypes.FailoverMode]            `tfsdk:"failover_mode"`
+	RecoveryWindow types.Int64                                          `tfsdk:"recovery_window"`
+	SourcePriority fwtypes.ListNestedObjectValueOf[sourcePriorityModel] `tfsdk:"source_priority"`
+	State          fwtypes.StringEnum[awstypes.State]                   `tfsdk:"state"`
+}
+
+type sourcePriorityModel struct {
+	PrimarySource types.String `tfsdk:"primary_source"`
+}
+
+type vpcInterfaceModel struct {
+	Name                 types.String                                      `tfsdk:"name"`
+	NetworkInterfaceIDs  fwtypes.SetValueOf[types.String]                  `tfsdk:"network_interface_ids"`
+	NetworkInterfaceType fwtypes.StringEnum[awstypes.NetworkInterfaceType] `tfsdk:"network_interface_type"`
+	RoleARN              fwtypes.ARN                                       `tfsdk:"role_arn"`
+	SecurityGroupIDs     fwtypes.SetValueOf[types.String]                  `tfsdk:"security_group_ids"`
+	SubnetID             types.String                                      `tfsdk:"subnet_id"`
+}
+
+func encryptionBlock(ctx context.Context) schema.ListNestedBlock {
+	return schema.ListNestedBlock{
+		CustomType: fwtypes.NewListNestedObjectTypeOf[encryptionModel](ctx),
+		Validators: []validator.List{
+			listvalidator.SizeAtMost(1),
+		},
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"algorithm": schema.StringAttribute{
+					CustomType: fwtypes.StringEnumType[awstypes.Algorithm](),
+					Optional:   true,
+				},
+				"constant_initialization_vector": schema.StringAttribute{
+					Optional: true,
+				},
+				"device_id": schema.StringAttribute{
+					Optional: true,
+				},
+				"key_type": schema.StringAttribute{
+					CustomType: fwtypes.StringEnumType[awstypes.KeyType](),
+					Optional:   true,
+					Computed:   true,
+				},
+				names.AttrRegion: schema.StringAttribute{
+					Optional: true,
+				},
+				names.AttrResourceID: schema.StringAttribute{
+					Optional: true,
+				},
+				names.AttrRoleARN: schema.StringAttribute{
+					CustomType: fwtypes.ARNType,
+					Required:   true,
+				},
+				"secret_arn": schema.StringAttribute{
+					CustomType: fwtypes.ARNType,
+					Optional:   true,
+				},
+				names.AttrURL: schema.StringAttribute{
+					Optional: true,
+				},
+			},
+		},
+	}
+}
+
+func sourceAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"data_transfer_subscriber_fee_percent": schema.Int64Attribute{
+			Computed: true,
+		},
+		names.AttrDescription: schema.StringAttribute{
+			Optional: true,
+		},
+		"entitlement_arn": schema.StringAttribute{
+			CustomType: fwtypes.ARNType,
+			Optional:   true,
+		},
+		"ingest_ip": schema.StringAttribute{
+			Computed: true,
+		},
+		"ingest_port": schema.Int64Attribute{
+			Optional: true,
+			Computed: true,
+		},
+		"max_bitrate": schema.Int64Attribute{
+			Optional: true,
+			Computed: true,
+		},
+		"max_latency": schema.Int64Attribute{
+			Optional: true,
+			Computed: true,
+		},
+		"max_sync_buffer": schema.Int64Attribute{
+			Optional: true,
+			Computed: true,
+		},
+		"min_latency": schema.Int64Attribute{
+			Optional: true,
+			Computed: true,
+		},
+		names.AttrName: schema.StringAttribute{
+			Required: true,
+		},
+		names.AttrProtocol: schema.StringAttribute{
+			CustomType: fwtypes.StringEnumType[awstypes.Protocol](),
+			Optional:   true,
+		},
+		"sender_control_port": schema.Int64Attribute{
+			Optional: true,
+		},
+		"sender_ip_address": schema.StringAttribute{
+			Optional: true,
+		},
+		"source_arn": schema.StringAttribute{
+			Computed: true,
+		},
+		"source_listener_address": schema.StringAttribute{
+			Optional: true,
+		},
+		"source_listener_port": schema.Int64Attribute{
+			Optional: true,
+		},
+		"stream_id": schema.StringAttribute{
+			Optional: true,
+		},
+		"vpc_interface_name": schema.StringAttribute{
+			Optional: true,
+		},
+		"whitelist_cidr": schema.StringAttribute{
+			Optional: true,
+		},
+	}
+}
+
+func sourceBlocks(ctx context.Context) map[string]schema.Block {
+	return map[string]schema.Block{
+		"decryption": encryptionBlock(ctx),
+		"gateway_bridge_source": schema.ListNestedBlock{
+			CustomType: fwtypes.NewListNestedObjectTypeOf[gatewayBridgeSourceModel](ctx),
+			Validators: []validator.List{
+				listvalidator.SizeAtMost(1),
+			},
+			NestedObject: schema.NestedBlockObject{
+				Attributes: map[string]schema.Attribute{
+					"bridge_arn": schema.StringAttribute{
+						CustomType: fwtypes.ARNType,
+						Required:   true,
+					},
+				},
+				Blocks: map[string]schema.Block{
+					"vpc_interface_attachment": vpcInterfaceAttachmentBlock(ctx),
+				},
+			},
+		},
+		"media_stream_source_configuration": schema.ListNestedBlock{
+			CustomType: fwtypes.NewListNestedObjectTypeOf[mediaStreamSourceConfigurationModel](ctx),
+			NestedObject: schema.NestedBlockObject{
+				Attributes: map[string]schema.Attribute{
+					"encoding_name": schema.StringAttribute{
+						CustomType: fwtypes.StringEnumType[awstypes.EncodingName](),
+						Required:   true,
+					},
+					"media_stream_name": schema.StringAttribute{
+						Required: true,
+					},
+				},
+				Blocks: map[string]schema.Block{
+					"input_configuration": schema.ListNestedBlock{
+						CustomType: fwtypes.NewListNestedObjectTypeOf[inputConfigurationModel](ctx),
+						NestedObject: schema.NestedBlockObject{
+							Attributes: map[string]schema.Attribute{
+								"input_ip": schema.StringAttribute{
+									Computed: true,
+								},
+								"input_port": schema.Int64Attribute{
+									Required: true,
+								},
+							},
+							Blocks: map[string]schema.Block{
+								"interface": interfaceBlock(ctx),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func interfaceBlock(ctx context.Context) schema.ListNestedBlock {
+	return schema.ListNestedBlock{
+		CustomType: fwtypes.NewListNestedObjectTypeOf[interfaceModel](ctx),
+		Validators: []validator.List{
+			listvalidator.IsRequired(),
+			listvalidator.SizeAtLeast(1),
+			listvalidator.SizeAtMost(1),
+		},
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				names.AttrName: schema.StringAttribute{
+					Required: true,
+				},
+			},
+		},
+	}
+}
+
+func vpcInterfaceAttachmentBlock(ctx context.Context) schema.ListNestedBlock {
+	return schema.ListNestedBlock{
+		CustomType: fwtypes.NewListNestedObjectTypeOf[vpcInterfaceAttachmentModel](ctx),
+		Validators: []validator.List{
+			listvalidator.SizeAtMost(1),
+		},
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"vpc_interface_name": schema.StringAttribute{
+					Optional: true,
+				},
+			},
+		},
+	}
+}
+
+// flattenSource flattens a source into target.
+// Transport-level settings are returned in a nested structure but configured at the top level.
+func flattenSource(ctx context.Context, apiObject *awstypes.Source, target any) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	diags.Append(fwflex.Flatten(ctx, apiObject, target)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	if apiObject.Transport != nil {
+		diags.Append(fwflex.Flatten(ctx, apiObject.Transport, target)...)
+	}
+
+	return diags
+}
+
+func flattenSources(ctx context.Context, apiObjects []awstypes.Source) ([]*sourceModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var models []*sourceModel
+
+	for _, apiObject := range apiObjects {
+		var model sourceModel
+		diags.Append(flattenSource(ctx, &apiObject, &model)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		models = append(models, &model)
+	}
+
+	return models, diags
+}
+
+type encryptionModel struct {
+	Algorithm                    fwtypes.StringEnum[awstypes.Algorithm] `tfsdk:"algorithm"`
+	ConstantInitializationVector types.String                           `tfsdk:"constant_initialization_vector"`
+	DeviceID                     types.String                           `tfsdk:"device_id"`
+	KeyType                      fwtypes.StringEnum[awstypes.KeyType]   `tfsdk:"key_type"`
+	Region                       types.String                           `tfsdk:"region"`
+	ResourceID                   types.String                           `tfsdk:"resource_id"`
+	RoleARN                      fwtypes.ARN                            `tfsdk:"role_arn"`
+	SecretARN                    fwtypes.ARN                            `tfsdk:"secret_arn"`
+	URL                          types.String                           `tfsdk:"url"`
+}
+
+type sourceModel struct {
+	DataTransferSubscriberFeePercent types.Int64                                                          `tfsdk:"data_transfer_subscriber_fee_percent"`
+	Decryption                       fwtypes.ListNestedObjectValueOf[encryptionModel]                     `tfsdk:"decryption"`
+	Description                      types.String                                                         `tfsdk:"description"`
+	EntitlementARN                   fwtypes.ARN                                                          `tfsdk:"entitlement_arn"`
+	GatewayBridgeSource              fwtypes.ListNestedObjectValueOf[gatewayBridgeSourceModel]            `tfsdk:"gateway_bridge_source"`
+	IngestIP                         types.String                                                         `tfsdk:"ingest_ip"`
+	IngestPort                       types.Int64                                                          `tfsdk:"ingest_port"`
+	MaxBitrate                       types.Int64                                                          `tfsdk:"max_bitrate"`
+	MaxLatency                       types.Int64                                                          `tfsdk:"max_latency"`
+	MaxSyncBuffer                    types.Int64                                                          `tfsdk:"max_sync_buffer"`
+	MediaStreamSourceConfigurations  fwtypes.ListNestedObjectValueOf[mediaStreamSourceConfigurationModel] `tfsdk:"media_stream_source_configuration"`
+	MinLatency                       types.Int64                                                          `tfsdk:"min_latency"`
+	Name                             types.String                                                         `tfsdk:"name"`
+	Protocol                         fwtypes.StringEnum[awstypes.Protocol]                                `tfsdk:"protocol"`
+	SenderControlPort                types.Int64                                                          `tfsdk:"sender_control_port"`
+	SenderIPAddress                  types.String                                                         `tfsdk:"sender_ip_address"`
+	SourceARN                        types.String                                                         `tfsdk:"source_arn"`
+	SourceListenerAddress            types.String                                                         `tfsdk:"source_listener_address"`
+	SourceListenerPort               types.Int64                                                          `tfsdk:"source_listener_port"`
+	StreamID                         types.String                                                         `tfsdk:"stream_id"`
+	VPCInterfaceName                 types.String                                                         `tfsdk:"vpc_interface_name"`
+	WhitelistCIDR                    types.String                                                         `tfsdk:"whitelist_cidr"`
+}
+
+type gatewayBridgeSourceModel struct {
+	BridgeARN              fwtypes.ARN                                                  `tfsdk:"bridge_arn"`
+	VPCInterfaceAttachment fwtypes.ListNestedObjectValueOf[vpcInterfaceAttachmentModel] `tfsdk:"vpc_interface_attachment"`
+}
+
+type vpcInterfaceAttachmentModel struct {
+	VPCInterfaceName types.String `tfsdk:"vpc_interface_name"`
+}
+
+type mediaStreamSourceConfigurationModel struct {
+	EncodingName        fwtypes.StringEnum[awstypes.EncodingName]                `tfsdk:"encoding_name"`
+	InputConfigurations fwtypes.ListNestedObjectValueOf[inputConfigurationModel] `tfsdk:"input_configuration"`
+	MediaStreamName     types.String                                             `tfsdk:"media_stream_name"`
+}
+
+type inputConfigurationModel struct {
+	InputIP   types.String                                    `tfsdk:"input_ip"`
+	InputPort types.Int64                                     `tfsdk:"input_port"`
+	Interface fwtypes.ListNestedObjectValueOf[interfaceModel] `tfsdk:"interface"`
+}
+
+type interfaceModel struct {
+	Name types.String `tfsdk:"name"`
+}
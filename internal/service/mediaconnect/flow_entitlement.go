@@ -0,0 +1,304 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mediaconnect
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/mediaconnect"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/mediaconnect/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_mediaconnect_flow_entitlement", name="Flow Entitlement")
+func newFlowEntitlementResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &flowEntitlementResource{}
+
+	return r, nil
+}
+
+type flowEntitlementResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+}
+
+func (*flowEntitlementResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_mediaconnect_flow_entitlement"
+}
+
+func (r *flowEntitlementResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"data_transfer_subscriber_fee_percent": schema.Int64Attribute{
+				Optional: true,
+				Computed: true,
+				Validators: []validator.Int64{
+					int64validator.Between(0, 100),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrDescription: schema.StringAttribute{
+				Optional: true,
+			},
+			"entitlement_arn": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"entitlement_status": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.EntitlementStatus](),
+				Optional:   true,
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"flow_arn": schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Required:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"subscribers": schema.SetAttribute{
+				CustomType:  fwtypes.SetOfStringType,
+				ElementType: types.StringType,
+				Required:    true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"encryption": encryptionBlock(ctx),
+		},
+	}
+}
+
+func (r *flowEntitlementResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data flowEntitlementResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().MediaConnectClient(ctx)
+
+	var apiObject awstypes.GrantEntitlementRequest
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, &apiObject)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	flowARN, name := data.FlowARN.ValueString(), data.Name.ValueString()
+	input := &mediaconnect.GrantFlowEntitlementsInput{
+		Entitlements: []awstypes.GrantEntitlementRequest{apiObject},
+		FlowArn:      aws.String(flowARN),
+	}
+
+	output, err := conn.GrantFlowEntitlements(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating MediaConnect Flow (%s) Entitlement (%s)", flowARN, name), err.Error())
+
+		return
+	}
+
+	if len(output.Entitlements) == 0 {
+		response.Diagnostics.AddError(fmt.Sprintf("creating MediaConnect Flow (%s) Entitlement (%s)", flowARN, name), tfresource.NewEmptyResultError(input).Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.EntitlementARN = fwflex.StringToFramework(ctx, output.Entitlements[0].EntitlementArn)
+	data.setID()
+
+	entitlement, err := findFlowEntitlementByTwoPartKey(ctx, conn, flowARN, data.EntitlementARN.ValueString())
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading MediaConnect Flow (%s) Entitlement (%s)", flowARN, name), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, entitlement, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *flowEntitlementResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data flowEntitlementResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if err := data.InitFromID(); err != nil {
+		response.Diagnostics.AddError("parsing resource ID", err.Error())
+
+		return
+	}
+
+	conn := r.Meta().MediaConnectClient(ctx)
+
+	entitlement, err := findFlowEntitlementByTwoPartKey(ctx, conn, data.FlowARN.ValueString(), data.EntitlementARN.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading MediaConnect Flow Entitlement (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, entitlement, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *flowEntitlementResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var new flowEntitlementResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().MediaConnectClient(ctx)
+
+	input := &mediaconnect.UpdateFlowEntitlementInput{}
+	response.Diagnostics.Append(fwflex.Expand(ctx, new, input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	output, err := conn.UpdateFlowEntitlement(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("updating MediaConnect Flow Entitlement (%s)", new.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output.Entitlement, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *flowEntitlementResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data flowEntitlementResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().MediaConnectClient(ctx)
+
+	_, err := conn.RevokeFlowEntitlement(ctx, &mediaconnect.RevokeFlowEntitlementInput{
+		EntitlementArn: aws.String(data.EntitlementARN.ValueString()),
+		FlowArn:        aws.String(data.FlowARN.ValueString()),
+	})
+
+	if errs.IsA[*awstypes.NotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting MediaConnect Flow Entitlement (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func findFlowEntitlementByTwoPartKey(ctx context.Context, conn *mediaconnect.Client, flowARN, entitlementARN string) (*awstypes.Entitlement, error) {
+	flow, err := findFlowByARN(ctx, conn, flowARN)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, v := range flow.Entitlements {
+		if aws.ToString(v.EntitlementArn) == entitlementARN {
+			return &v, nil
+		}
+	}
+
+	return nil, &retry.NotFoundError{}
+}
+
+type flowEntitlementResourceModel struct {
+	DataTransferSubscriberFeePercent types.Int64                                      `tfsdk:"data_transfer_subscriber_fee_percent"`
+	Description                      types.String                                     `tfsdk:"description"`
+	Encryption                       fwtypes.ListNestedObjectValueOf[encryptionModel] `tfsdk:"encryption"`
+	EntitlementARN                   types.String                                     `tfsdk:"entitlement_arn"`
+	EntitlementStatus                fwtypes.StringEnum[awstypes.EntitlementStatus]   `tfsdk:"entitlement_status"`
+	FlowARN                          fwtypes.ARN                                      `tfsdk:"flow_arn"`
+	ID                               types.String                                     `tfsdk:"id"`
+	Name                             types.String                                     `tfsdk:"name"`
+	Subscribers                      fwtypes.SetValueOf[types.String]                 `tfsdk:"subscribers"`
+}
+
+const (
+	flowEntitlementResourceIDPartCount = 2
+)
+
+func (data *flowEntitlementResourceModel) InitFromID() error {
+	parts, err := flex.ExpandResourceId(data.ID.ValueString(), flowEntitlementResourceIDPartCount, false)
+	if err != nil {
+		return err
+	}
+
+	data.FlowARN = fwtypes.ARNValue(parts[0])
+	data.EntitlementARN = types.StringValue(parts[1])
+
+	return nil
+}
+
+func (data *flowEntitlementResourceModel) setID() {
+	data.ID = types.StringValue(errs.Must(flex.FlattenResourceId([]string{data.FlowARN.ValueString(), data.EntitlementARN.ValueString()}, flowEntitlementResourceIDPartCount, false)))
+}
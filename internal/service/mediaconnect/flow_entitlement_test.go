@@ -0,0 +1,144 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mediaconnect_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/mediaconnect/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfmediaconnect "github.com/hashicorp/terraform-provider-aws/internal/service/mediaconnect"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccMediaConnectFlowEntitlement_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.Entitlement
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_mediaconnect_flow_entitlement.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.MediaConnectServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFlowEntitlementDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFlowEntitlementConfig_basic(rName, "description 1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckFlowEntitlementExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttrSet(resourceName, "entitlement_arn"),
+					resource.TestCheckResourceAttr(resourceName, "entitlement_status", string(awstypes.EntitlementStatusEnabled)),
+					resource.TestCheckResourceAttrPair(resourceName, "flow_arn", "aws_mediaconnect_flow.test", names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, "entitlement-1"),
+					resource.TestCheckResourceAttr(resourceName, "subscribers.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, "description 1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccFlowEntitlementConfig_basic(rName, "description 2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckFlowEntitlementExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, "description 2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccMediaConnectFlowEntitlement_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.Entitlement
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_mediaconnect_flow_entitlement.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.MediaConnectServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFlowEntitlementDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFlowEntitlementConfig_basic(rName, "description 1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckFlowEntitlementExists(ctx, resourceName, &v),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfmediaconnect.ResourceFlowEntitlement, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckFlowEntitlementDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).MediaConnectClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_mediaconnect_flow_entitlement" {
+				continue
+			}
+
+			_, err := tfmediaconnect.FindFlowEntitlementByTwoPartKey(ctx, conn, rs.Primary.Attributes["flow_arn"], rs.Primary.Attributes["entitlement_arn"])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("MediaConnect Flow Entitlement %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckFlowEntitlementExists(ctx context.Context, n string, v *awstypes.Entitlement) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).MediaConnectClient(ctx)
+
+		output, err := tfmediaconnect.FindFlowEntitlementByTwoPartKey(ctx, conn, rs.Primary.Attributes["flow_arn"], rs.Primary.Attributes["entitlement_arn"])
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccFlowEntitlementConfig_basic(rName, description string) string {
+	return acctest.ConfigCompose(testAccFlowConfig_basic(rName), fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+resource "aws_mediaconnect_flow_entitlement" "test" {
+  flow_arn    = aws_mediaconnect_flow.test.arn
+  name        = "entitlement-1"
+  subscribers = [data.aws_caller_identity.current.account_id]
+  description = %[1]q
+}
+`, description))
+}
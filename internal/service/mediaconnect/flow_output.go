@@ -0,0 +1,450 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mediaconnect
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/mediaconnect"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/mediaconnect/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_mediaconnect_flow_output", name="Flow Output")
+func newFlowOutputResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &flowOutputResource{}
+
+	return r, nil
+}
+
+type flowOutputResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+}
+
+func (*flowOutputResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_mediaconnect_flow_output"
+}
+
+func (r *flowOutputResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"cidr_allow_list": schema.SetAttribute{
+				CustomType:  fwtypes.SetOfStringType,
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"data_transfer_subscriber_fee_percent": schema.Int64Attribute{
+				Computed: true,
+			},
+			names.AttrDescription: schema.StringAttribute{
+				Optional: true,
+			},
+			names.AttrDestination: schema.StringAttribute{
+				Optional: true,
+			},
+			"flow_arn": schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Required:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			"listener_address": schema.StringAttribute{
+				Computed: true,
+			},
+			"max_latency": schema.Int64Attribute{
+				Optional: true,
+				Computed: true,
+			},
+			"media_live_input_arn": schema.StringAttribute{
+				Computed: true,
+			},
+			"min_latency": schema.Int64Attribute{
+				Optional: true,
+				Computed: true,
+			},
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"output_arn": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrPort: schema.Int64Attribute{
+				Optional: true,
+			},
+			names.AttrProtocol: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.Protocol](),
+				Required:   true,
+			},
+			"remote_id": schema.StringAttribute{
+				Optional: true,
+			},
+			"sender_control_port": schema.Int64Attribute{
+				Optional: true,
+			},
+			"smoothing_latency": schema.Int64Attribute{
+				Optional: true,
+				Computed: true,
+			},
+			"stream_id": schema.StringAttribute{
+				Optional: true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"encryption": encryptionBlock(ctx),
+			"media_stream_output_configuration": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[mediaStreamOutputConfigurationModel](ctx),
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"encoding_name": schema.StringAttribute{
+							CustomType: fwtypes.StringEnumType[awstypes.EncodingName](),
+							Required:   true,
+						},
+						"media_stream_name": schema.StringAttribute{
+							Required: true,
+						},
+					},
+					Blocks: map[string]schema.Block{
+						"destination_configuration": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[destinationConfigurationModel](ctx),
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"destination_ip": schema.StringAttribute{
+										Required: true,
+									},
+									"destination_port": schema.Int64Attribute{
+										Required: true,
+									},
+									"outbound_ip": schema.StringAttribute{
+										Computed: true,
+									},
+								},
+								Blocks: map[string]schema.Block{
+									"interface": interfaceBlock(ctx),
+								},
+							},
+						},
+						"encoding_parameters": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[encodingParametersModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"compression_factor": schema.Float64Attribute{
+										Required: true,
+									},
+									"encoder_profile": schema.StringAttribute{
+										CustomType: fwtypes.StringEnumType[awstypes.EncoderProfile](),
+										Optional:   true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"vpc_interface_attachment": vpcInterfaceAttachmentBlock(ctx),
+		},
+	}
+}
+
+func (r *flowOutputResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data flowOutputResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().MediaConnectClient(ctx)
+
+	var apiObject awstypes.AddOutputRequest
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, &apiObject)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	flowARN, name := data.FlowARN.ValueString(), data.Name.ValueString()
+	input := &mediaconnect.AddFlowOutputsInput{
+		FlowArn: aws.String(flowARN),
+		Outputs: []awstypes.AddOutputRequest{apiObject},
+	}
+
+	output, err := conn.AddFlowOutputs(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating MediaConnect Flow (%s) Output (%s)", flowARN, name), err.Error())
+
+		return
+	}
+
+	if len(output.Outputs) == 0 {
+		response.Diagnostics.AddError(fmt.Sprintf("creating MediaConnect Flow (%s) Output (%s)", flowARN, name), tfresource.NewEmptyResultError(input).Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.OutputARN = fwflex.StringToFramework(ctx, output.Outputs[0].OutputArn)
+	data.setID()
+
+	if _, err := waitFlowUpdated(ctx, conn, flowARN, flowUpdateTimeout); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for MediaConnect Flow (%s) update", flowARN), err.Error())
+
+		return
+	}
+
+	out, err := findFlowOutputByTwoPartKey(ctx, conn, flowARN, data.OutputARN.ValueString())
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading MediaConnect Flow (%s) Output (%s)", flowARN, name), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(flattenOutput(ctx, out, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *flowOutputResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data flowOutputResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if err := data.InitFromID(); err != nil {
+		response.Diagnostics.AddError("parsing resource ID", err.Error())
+
+		return
+	}
+
+	conn := r.Meta().MediaConnectClient(ctx)
+
+	output, err := findFlowOutputByTwoPartKey(ctx, conn, data.FlowARN.ValueString(), data.OutputARN.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading MediaConnect Flow Output (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(flattenOutput(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *flowOutputResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var new flowOutputResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().MediaConnectClient(ctx)
+
+	input := &mediaconnect.UpdateFlowOutputInput{}
+	response.Diagnostics.Append(fwflex.Expand(ctx, new, input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := conn.UpdateFlowOutput(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("updating MediaConnect Flow Output (%s)", new.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	flowARN := new.FlowARN.ValueString()
+	if _, err := waitFlowUpdated(ctx, conn, flowARN, flowUpdateTimeout); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for MediaConnect Flow (%s) update", flowARN), err.Error())
+
+		return
+	}
+
+	output, err := findFlowOutputByTwoPartKey(ctx, conn, flowARN, new.OutputARN.ValueString())
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading MediaConnect Flow Output (%s)", new.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(flattenOutput(ctx, output, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *flowOutputResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data flowOutputResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().MediaConnectClient(ctx)
+
+	flowARN := data.FlowARN.ValueString()
+	_, err := conn.RemoveFlowOutput(ctx, &mediaconnect.RemoveFlowOutputInput{
+		FlowArn:   aws.String(flowARN),
+		OutputArn: aws.String(data.OutputARN.ValueString()),
+	})
+
+	if errs.IsA[*awstypes.NotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting MediaConnect Flow Output (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	if _, err := waitFlowUpdated(ctx, conn, flowARN, flowUpdateTimeout); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for MediaConnect Flow (%s) update", flowARN), err.Error())
+
+		return
+	}
+}
+
+func findFlowOutputByTwoPartKey(ctx context.Context, conn *mediaconnect.Client, flowARN, outputARN string) (*awstypes.Output, error) {
+	flow, err := findFlowByARN(ctx, conn, flowARN)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, v := range flow.Outputs {
+		if aws.ToString(v.OutputArn) == outputARN {
+			return &v, nil
+		}
+	}
+
+	return nil, &retry.NotFoundError{}
+}
+
+// flattenOutput flattens an output into target.
+// Transport-level settings are returned in a nested structure but configured at the top level.
+func flattenOutput(ctx context.Context, apiObject *awstypes.Output, target any) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	diags.Append(fwflex.Flatten(ctx, apiObject, target)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	if apiObject.Transport != nil {
+		diags.Append(fwflex.Flatten(ctx, apiObject.Transport, target)...)
+	}
+
+	return diags
+}
+
+type flowOutputResourceModel struct {
+	CIDRAllowList                    fwtypes.SetValueOf[types.String]                                     `tfsdk:"cidr_allow_list"`
+	DataTransferSubscriberFeePercent types.Int64                                                          `tfsdk:"data_transfer_subscriber_fee_percent"`
+	Description                      types.String                                                         `tfsdk:"description"`
+	Destination                      types.String                                                         `tfsdk:"destination"`
+	Encryption                       fwtypes.ListNestedObjectValueOf[encryptionModel]                     `tfsdk:"encryption"`
+	FlowARN                          fwtypes.ARN                                                          `tfsdk:"flow_arn"`
+	ID                               types.String                                                         `tfsdk:"id"`
+	ListenerAddress                  types.String                                                         `tfsdk:"listener_address"`
+	MaxLatency                       types.Int64                                                          `tfsdk:"max_latency"`
+	MediaLiveInputARN                types.String                                                         `tfsdk:"media_live_input_arn"`
+	MediaStreamOutputConfigurations  fwtypes.ListNestedObjectValueOf[mediaStreamOutputConfigurationModel] `tfsdk:"media_stream_output_configuration"`
+	MinLatency                       types.Int64                                                          `tfsdk:"min_latency"`
+	Name                             types.String                                                         `tfsdk:"name"`
+	OutputARN                        types.String                                                         `tfsdk:"output_arn"`
+	Port                             types.Int64                                                          `tfsdk:"port"`
+	Protocol                         fwtypes.StringEnum[awstypes.Protocol]                                `tfsdk:"protocol"`
+	RemoteID                         types.String                                                         `tfsdk:"remote_id"`
+	SenderControlPort                types.Int64                                                          `tfsdk:"sender_control_port"`
+	SmoothingLatency                 types.Int64                                                          `tfsdk:"smoothing_latency"`
+	StreamID                         types.String                                                         `tfsdk:"stream_id"`
+	VPCInterfaceAttachment           fwtypes.ListNestedObjectValueOf[vpcInterfaceAttachmentModel]         `tfsdk:"vpc_interface_attachment"`
+}
+
+const (
+	flowOutputResourceIDPartCount = 2
+)
+
+func (data *flowOutputResourceModel) InitFromID() error {
+	parts, err := flex.ExpandResourceId(data.ID.ValueString(), flowOutputResourceIDPartCount, false)
+	if err != nil {
+		return err
+	}
+
+	data.FlowARN = fwtypes.ARNValue(parts[0])
+	data.OutputARN = types.StringValue(parts[1])
+
+	return nil
+}
+
+func (data *flowOutputResourceModel) setID() {
+	data.ID = types.StringValue(errs.Must(flex.FlattenResourceId([]string{data.FlowARN.ValueString(), data.OutputARN.ValueString()}, flowOutputResourceIDPartCount, false)))
+}
+
+type mediaStreamOutputConfigurationModel struct {
+	DestinationConfigurations fwtypes.ListNestedObjectValueOf[destinationConfigurationModel] `tfsdk:"destination_configuration"`
+	EncodingName              fwtypes.StringEnum[awstypes.EncodingName]                      `tfsdk:"encoding_name"`
+	EncodingParameters        fwtypes.ListNestedObjectValueOf[encodingParametersModel]       `tfsdk:"encoding_parameters"`
+	MediaStreamName           types.String                                                   `tfsdk:"media_stream_name"`
+}
+
+type destinationConfigurationModel struct {
+	DestinationIP   types.String                                    `tfsdk:"destination_ip"`
+	DestinationPort types.Int64                                     `tfsdk:"destination_port"`
+	Interface       fwtypes.ListNestedObjectValueOf[interfaceModel] `tfsdk:"interface"`
+	OutboundIP      types.String                                    `tfsdk:"outbound_ip"`
+}
+
+type encodingParametersModel struct {
+	CompressionFactor types.Float64                               `tfsdk:"compression_factor"`
+	EncoderProfile    fwtypes.StringEnum[awstypes.EncoderProfile] `tfsdk:"encoder_profile"`
+}
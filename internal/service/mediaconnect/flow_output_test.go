@@ -0,0 +1,145 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mediaconnect_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/mediaconnect/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfmediaconnect "github.com/hashicorp/terraform-provider-aws/internal/service/mediaconnect"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccMediaConnectFlowOutput_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.Output
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_mediaconnect_flow_output.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.MediaConnectServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFlowOutputDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFlowOutputConfig_basic(rName, "description 1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckFlowOutputExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDestination, "198.51.100.10"),
+					resource.TestCheckResourceAttrPair(resourceName, "flow_arn", "aws_mediaconnect_flow.test", names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, "output-1"),
+					resource.TestCheckResourceAttrSet(resourceName, "output_arn"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrPort, "5010"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrProtocol, "srt-caller"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, "description 1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccFlowOutputConfig_basic(rName, "description 2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckFlowOutputExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, "description 2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccMediaConnectFlowOutput_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.Output
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_mediaconnect_flow_output.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.MediaConnectServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFlowOutputDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFlowOutputConfig_basic(rName, "description 1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckFlowOutputExists(ctx, resourceName, &v),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfmediaconnect.ResourceFlowOutput, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckFlowOutputDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).MediaConnectClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_mediaconnect_flow_output" {
+				continue
+			}
+
+			_, err := tfmediaconnect.FindFlowOutputByTwoPartKey(ctx, conn, rs.Primary.Attributes["flow_arn"], rs.Primary.Attributes["output_arn"])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("MediaConnect Flow Output %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckFlowOutputExists(ctx context.Context, n string, v *awstypes.Output) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).MediaConnectClient(ctx)
+
+		output, err := tfmediaconnect.FindFlowOutputByTwoPartKey(ctx, conn, rs.Primary.Attributes["flow_arn"], rs.Primary.Attributes["output_arn"])
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccFlowOutputConfig_basic(rName, description string) string {
+	return acctest.ConfigCompose(testAccFlowConfig_basic(rName), fmt.Sprintf(`
+resource "aws_mediaconnect_flow_output" "test" {
+  flow_arn    = aws_mediaconnect_flow.test.arn
+  name        = "output-1"
+  protocol    = "srt-caller"
+  destination = "198.51.100.10"
+  port        = 5010
+  description = %[1]q
+}
+`, description))
+}
@@ -0,0 +1,312 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mediaconnect
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/mediaconnect"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/mediaconnect/types"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_mediaconnect_flow_source", name="Flow Source")
+func newFlowSourceResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &flowSourceResource{}
+
+	return r, nil
+}
+
+type flowSourceResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+}
+
+func (*flowSourceResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_mediaconnect_flow_source"
+}
+
+func (r *flowSourceResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	attributes := sourceAttributes()
+	attributes["flow_arn"] = schema.StringAttribute{
+		CustomType: fwtypes.ARNType,
+		Required:   true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		},
+	}
+	attributes[names.AttrID] = framework.IDAttribute()
+	attributes[names.AttrName] = schema.StringAttribute{
+		Required: true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		},
+	}
+	attributes["source_arn"] = schema.StringAttribute{
+		Computed: true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+		},
+	}
+
+	response.Schema = schema.Schema{
+		Attributes: attributes,
+		Blocks:     sourceBlocks(ctx),
+	}
+}
+
+func (r *flowSourceResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data flowSourceResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().MediaConnectClient(ctx)
+
+	var apiObject awstypes.SetSourceRequest
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, &apiObject)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	flowARN, name := data.FlowARN.ValueString(), data.Name.ValueString()
+	input := &mediaconnect.AddFlowSourcesInput{
+		FlowArn: aws.String(flowARN),
+		Sources: []awstypes.SetSourceRequest{apiObject},
+	}
+
+	output, err := conn.AddFlowSources(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating MediaConnect Flow (%s) Source (%s)", flowARN, name), err.Error())
+
+		return
+	}
+
+	if len(output.Sources) == 0 {
+		response.Diagnostics.AddError(fmt.Sprintf("creating MediaConnect Flow (%s) Source (%s)", flowARN, name), tfresource.NewEmptyResultError(input).Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.SourceARN = fwflex.StringToFramework(ctx, output.Sources[0].SourceArn)
+	data.setID()
+
+	if _, err := waitFlowUpdated(ctx, conn, flowARN, flowUpdateTimeout); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for MediaConnect Flow (%s) update", flowARN), err.Error())
+
+		return
+	}
+
+	source, err := findFlowSourceByTwoPartKey(ctx, conn, flowARN, data.SourceARN.ValueString())
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading MediaConnect Flow (%s) Source (%s)", flowARN, name), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(flattenSource(ctx, source, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *flowSourceResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data flowSourceResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if err := data.InitFromID(); err != nil {
+		response.Diagnostics.AddError("parsing resource ID", err.Error())
+
+		return
+	}
+
+	conn := r.Meta().MediaConnectClient(ctx)
+
+	source, err := findFlowSourceByTwoPartKey(ctx, conn, data.FlowARN.ValueString(), data.SourceARN.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading MediaConnect Flow Source (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(flattenSource(ctx, source, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *flowSourceResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var new flowSourceResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().MediaConnectClient(ctx)
+
+	input := &mediaconnect.UpdateFlowSourceInput{}
+	response.Diagnostics.Append(fwflex.Expand(ctx, new, input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := conn.UpdateFlowSource(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("updating MediaConnect Flow Source (%s)", new.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	flowARN := new.FlowARN.ValueString()
+	if _, err := waitFlowUpdated(ctx, conn, flowARN, flowUpdateTimeout); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for MediaConnect Flow (%s) update", flowARN), err.Error())
+
+		return
+	}
+
+	source, err := findFlowSourceByTwoPartKey(ctx, conn, flowARN, new.SourceARN.ValueString())
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading MediaConnect Flow Source (%s)", new.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(flattenSource(ctx, source, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *flowSourceResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data flowSourceResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().MediaConnectClient(ctx)
+
+	flowARN := data.FlowARN.ValueString()
+	_, err := conn.RemoveFlowSource(ctx, &mediaconnect.RemoveFlowSourceInput{
+		FlowArn:   aws.String(flowARN),
+		SourceArn: aws.String(data.SourceARN.ValueString()),
+	})
+
+	if errs.IsA[*awstypes.NotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting MediaConnect Flow Source (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	if _, err := waitFlowUpdated(ctx, conn, flowARN, flowUpdateTimeout); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for MediaConnect Flow (%s) update", flowARN), err.Error())
+
+		return
+	}
+}
+
+func findFlowSourceByTwoPartKey(ctx context.Context, conn *mediaconnect.Client, flowARN, sourceARN string) (*awstypes.Source, error) {
+	flow, err := findFlowByARN(ctx, conn, flowARN)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, v := range flow.Sources {
+		if aws.ToString(v.SourceArn) == sourceARN {
+			return &v, nil
+		}
+	}
+
+	return nil, &retry.NotFoundError{}
+}
+
+type flowSourceResourceModel struct {
+	DataTransferSubscriberFeePercent types.Int64                                                          `tfsdk:"data_transfer_subscriber_fee_percent"`
+	Decryption                       fwtypes.ListNestedObjectValueOf[encryptionModel]                     `tfsdk:"decryption"`
+	Description                      types.String                                                         `tfsdk:"description"`
+	EntitlementARN                   fwtypes.ARN                                                          `tfsdk:"entitlement_arn"`
+	FlowARN                          fwtypes.ARN                                                          `tfsdk:"flow_arn"`
+	GatewayBridgeSource              fwtypes.ListNestedObjectValueOf[gatewayBridgeSourceModel]            `tfsdk:"gateway_bridge_source"`
+	ID                               types.String                                                         `tfsdk:"id"`
+	IngestIP                         types.String                                                         `tfsdk:"ingest_ip"`
+	IngestPort                       types.Int64                                                          `tfsdk:"ingest_port"`
+	MaxBitrate                       types.Int64                                                          `tfsdk:"max_bitrate"`
+	MaxLatency                       types.Int64                                                          `tfsdk:"max_latency"`
+	MaxSyncBuffer                    types.Int64                                                          `tfsdk:"max_sync_buffer"`
+	MediaStreamSourceConfigurations  fwtypes.ListNestedObjectValueOf[mediaStreamSourceConfigurationModel] `tfsdk:"media_stream_source_configuration"`
+	MinLatency                       types.Int64                                                          `tfsdk:"min_latency"`
+	Name                             types.String                                                         `tfsdk:"name"`
+	Protocol                         fwtypes.StringEnum[awstypes.Protocol]                                `tfsdk:"protocol"`
+	SenderControlPort                types.Int64                                                          `tfsdk:"sender_control_port"`
+	SenderIPAddress                  types.String                                                         `tfsdk:"sender_ip_address"`
+	SourceARN                        types.String                                                         `tfsdk:"source_arn"`
+	SourceListenerAddress            types.String                                                         `tfsdk:"source_listener_address"`
+	SourceListenerPort               types.Int64                                                          `tfsdk:"source_listener_port"`
+	StreamID                         types.String                                                         `tfsdk:"stream_id"`
+	VPCInterfaceName                 types.String                                                         `tfsdk:"vpc_interface_name"`
+	WhitelistCIDR                    types.String                                                         `tfsdk:"whitelist_cidr"`
+}
+
+const (
+	flowSourceResourceIDPartCount = 2
+)
+
+func (data *flowSourceResourceModel) InitFromID() error {
+	parts, err := flex.ExpandResourceId(data.ID.ValueString(), flowSourceResourceIDPartCount, false)
+	if err != nil {
+		return err
+	}
+
+	data.FlowARN = fwtypes.ARNValue(parts[0])
+	data.SourceARN = types.StringValue(parts[1])
+
+	return nil
+}
+
+func (data *flowSourceResourceModel) setID() {
+	data.ID = types.StringValue(errs.Must(flex.FlattenResourceId([]string{data.FlowARN.ValueString(), data.SourceARN.ValueString()}, flowSourceResourceIDPartCount, false)))
+}
@@ -0,0 +1,165 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mediaconnect_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/mediaconnect/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfmediaconnect "github.com/hashicorp/terraform-provider-aws/internal/service/mediaconnect"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccMediaConnectFlowSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.Source
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_mediaconnect_flow_source.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.MediaConnectServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFlowSourceDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFlowSourceConfig_basic(rName, "10.0.0.0/16"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckFlowSourceExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttrPair(resourceName, "flow_arn", "aws_mediaconnect_flow.test", names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, "source-2"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrProtocol, "srt-listener"),
+					resource.TestCheckResourceAttrSet(resourceName, "ingest_ip"),
+					resource.TestCheckResourceAttrSet(resourceName, "source_arn"),
+					resource.TestCheckResourceAttr(resourceName, "whitelist_cidr", "10.0.0.0/16"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccFlowSourceConfig_basic(rName, "10.1.0.0/16"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckFlowSourceExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "whitelist_cidr", "10.1.0.0/16"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccMediaConnectFlowSource_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.Source
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_mediaconnect_flow_source.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.MediaConnectServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFlowSourceDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFlowSourceConfig_basic(rName, "10.0.0.0/16"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckFlowSourceExists(ctx, resourceName, &v),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfmediaconnect.ResourceFlowSource, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckFlowSourceDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).MediaConnectClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_mediaconnect_flow_source" {
+				continue
+			}
+
+			_, err := tfmediaconnect.FindFlowSourceByTwoPartKey(ctx, conn, rs.Primary.Attributes["flow_arn"], rs.Primary.Attributes["source_arn"])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("MediaConnect Flow Source %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckFlowSourceExists(ctx context.Context, n string, v *awstypes.Source) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).MediaConnectClient(ctx)
+
+		output, err := tfmediaconnect.FindFlowSourceByTwoPartKey(ctx, conn, rs.Primary.Attributes["flow_arn"], rs.Primary.Attributes["source_arn"])
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+// Flows only accept a second source when source failover is enabled.
+func testAccFlowSourceConfig_base(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_mediaconnect_flow" "test" {
+  name = %[1]q
+
+  source {
+    name           = "source-1"
+    protocol       = "srt-listener"
+    ingest_port    = 5000
+    whitelist_cidr = "10.0.0.0/16"
+  }
+
+  source_failover_config {
+    failover_mode   = "MERGE"
+    recovery_window = 200
+    state           = "ENABLED"
+  }
+}
+`, rName)
+}
+
+func testAccFlowSourceConfig_basic(rName, whitelistCIDR string) string {
+	return acctest.ConfigCompose(testAccFlowSourceConfig_base(rName), fmt.Sprintf(`
+resource "aws_mediaconnect_flow_source" "test" {
+  flow_arn       = aws_mediaconnect_flow.test.arn
+  name           = "source-2"
+  protocol       = "srt-listener"
+  ingest_port    = 5001
+  whitelist_cidr = %[1]q
+}
+`, whitelistCIDR))
+}
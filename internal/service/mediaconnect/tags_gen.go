@@ -79,6 +79,15 @@ func setTagsOut(ctx context.Context, tags map[string]string) {
 	}
 }
 
+// createTags creates mediaconnect service tags for new resources.
+func createTags(ctx context.Context, conn *mediaconnect.Client, identifier string, tags map[string]string) error {
+	if len(tags) == 0 {
+		return nil
+	}
+
+	return updateTags(ctx, conn, identifier, nil, tags)
+}
+
 // updateTags updates mediaconnect service tags.
 // The identifier is typically the Amazon Resource Name (ARN), although
 // it may also be a different identifier depending on the service.
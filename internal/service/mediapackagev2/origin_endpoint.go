@@ -0,0 +1,806 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mediapackagev2
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/mediapackagev2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/mediapackagev2/types"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	sdkid "github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_mediapackagev2_origin_endpoint", name="Origin Endpoint")
+// @Tags(identifierAttribute="arn")
+func newOriginEndpointResource(context.Context) (resource.ResourceWithConfigure, error) {
+	return &originEndpointResource{}, nil
+}
+
+type originEndpointResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+}
+
+func (*originEndpointResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_mediapackagev2_origin_endpoint"
+}
+
+func (r *originEndpointResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	filterConfigurationBlock := schema.ListNestedBlock{
+		CustomType: fwtypes.NewListNestedObjectTypeOf[filterConfigurationModel](ctx),
+		Validators: []validator.List{
+			listvalidator.SizeAtMost(1),
+		},
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"end": schema.StringAttribute{
+					CustomType: timetypes.RFC3339Type{},
+					Optional:   true,
+				},
+				"manifest_filter": schema.StringAttribute{
+					Optional: true,
+					Validators: []validator.String{
+						stringvalidator.LengthBetween(1, 1024),
+					},
+				},
+				"start": schema.StringAttribute{
+					CustomType: timetypes.RFC3339Type{},
+					Optional:   true,
+				},
+				"time_delay_seconds": schema.Int64Attribute{
+					Optional: true,
+					Validators: []validator.Int64{
+						int64validator.Between(0, 1209600),
+					},
+				},
+			},
+		},
+	}
+	scteHLSBlock := schema.ListNestedBlock{
+		CustomType: fwtypes.NewListNestedObjectTypeOf[scteHLSModel](ctx),
+		Validators: []validator.List{
+			listvalidator.SizeAtMost(1),
+		},
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"ad_marker_hls": schema.StringAttribute{
+					CustomType: fwtypes.StringEnumType[awstypes.AdMarkerHls](),
+					Optional:   true,
+				},
+			},
+		},
+	}
+	hlsManifestBlock := schema.ListNestedBlock{
+		CustomType: fwtypes.NewListNestedObjectTypeOf[hlsManifestModel](ctx),
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"child_manifest_name": schema.StringAttribute{
+					Optional: true,
+					Computed: true,
+					Validators: []validator.String{
+						resourceNameValidator,
+					},
+				},
+				"manifest_name": schema.StringAttribute{
+					Required: true,
+					Validators: []validator.String{
+						resourceNameValidator,
+					},
+				},
+				"manifest_window_seconds": schema.Int64Attribute{
+					Optional: true,
+					Computed: true,
+					Validators: []validator.Int64{
+						int64validator.Between(30, 900),
+					},
+				},
+				"program_date_time_interval_seconds": schema.Int64Attribute{
+					Optional: true,
+					Validators: []validator.Int64{
+						int64validator.Between(1, 1209600),
+					},
+				},
+				names.AttrURL: schema.StringAttribute{
+					Computed: true,
+				},
+			},
+			Blocks: map[string]schema.Block{
+				"filter_configuration": filterConfigurationBlock,
+				"scte_hls":             scteHLSBlock,
+			},
+		},
+	}
+
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			"channel_group_name": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					resourceNameValidator,
+				},
+			},
+			"channel_name": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					resourceNameValidator,
+				},
+			},
+			"container_type": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.ContainerType](),
+				Required:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrCreatedAt: schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrDescription: schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(0, 1024),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					resourceNameValidator,
+				},
+			},
+			"startover_window_seconds": schema.Int64Attribute{
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.Between(60, 1209600),
+				},
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+		},
+		Blocks: map[string]schema.Block{
+			"dash_manifest": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[dashManifestModel](ctx),
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"drm_signaling": schema.StringAttribute{
+							CustomType: fwtypes.StringEnumType[awstypes.DashDrmSignaling](),
+							Optional:   true,
+							Computed:   true,
+						},
+						"manifest_name": schema.StringAttribute{
+							Required: true,
+							Validators: []validator.String{
+								resourceNameValidator,
+							},
+						},
+						"manifest_window_seconds": schema.Int64Attribute{
+							Optional: true,
+							Computed: true,
+							Validators: []validator.Int64{
+								int64validator.Between(30, 5184000),
+							},
+						},
+						"min_buffer_time_seconds": schema.Int64Attribute{
+							Optional: true,
+							Computed: true,
+							Validators: []validator.Int64{
+								int64validator.Between(0, 3600),
+							},
+						},
+						"min_update_period_seconds": schema.Int64Attribute{
+							Optional: true,
+							Computed: true,
+							Validators: []validator.Int64{
+								int64validator.Between(1, 3600),
+							},
+						},
+						"period_triggers": schema.SetAttribute{
+							CustomType:  fwtypes.SetOfStringType,
+							ElementType: types.StringType,
+							Optional:    true,
+							Computed:    true,
+							Validators: []validator.Set{
+								setvalidator.ValueStringsAre(stringvalidator.OneOf(enum.Values[awstypes.DashPeriodTrigger]()...)),
+							},
+						},
+						"segment_template_format": schema.StringAttribute{
+							CustomType: fwtypes.StringEnumType[awstypes.DashSegmentTemplateFormat](),
+							Optional:   true,
+							Computed:   true,
+						},
+						"suggested_presentation_delay_seconds": schema.Int64Attribute{
+							Optional: true,
+							Computed: true,
+							Validators: []validator.Int64{
+								int64validator.Between(0, 3600),
+							},
+						},
+						names.AttrURL: schema.StringAttribute{
+							Computed: true,
+						},
+					},
+					Blocks: map[string]schema.Block{
+						"filter_configuration": filterConfigurationBlock,
+						"scte_dash": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[scteDASHModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"ad_marker_dash": schema.StringAttribute{
+										CustomType: fwtypes.StringEnumType[awstypes.AdMarkerDash](),
+										Optional:   true,
+									},
+								},
+							},
+						},
+						"utc_timing": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[dashUTCTimingModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"timing_mode": schema.StringAttribute{
+										CustomType: fwtypes.StringEnumType[awstypes.DashUtcTimingMode](),
+										Optional:   true,
+									},
+									"timing_source": schema.StringAttribute{
+										Optional: true,
+										Validators: []validator.String{
+											stringvalidator.LengthBetween(1, 1024),
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"hls_manifest":             hlsManifestBlock,
+			"low_latency_hls_manifest": hlsManifestBlock,
+			"segment": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[segmentModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"include_iframe_only_streams": schema.BoolAttribute{
+							Optional: true,
+							Computed: true,
+							PlanModifiers: []planmodifier.Bool{
+								boolplanmodifier.UseStateForUnknown(),
+							},
+						},
+						"segment_duration_seconds": schema.Int64Attribute{
+							Optional: true,
+							Computed: true,
+							PlanModifiers: []planmodifier.Int64{
+								int64planmodifier.UseStateForUnknown(),
+							},
+							Validators: []validator.Int64{
+								int64validator.Between(1, 30),
+							},
+						},
+						"segment_name": schema.StringAttribute{
+							Optional: true,
+							Computed: true,
+							PlanModifiers: []planmodifier.String{
+								stringplanmodifier.UseStateForUnknown(),
+							},
+							Validators: []validator.String{
+								resourceNameValidator,
+							},
+						},
+						"ts_include_dvb_subtitles": schema.BoolAttribute{
+							Optional: true,
+							Computed: true,
+							PlanModifiers: []planmodifier.Bool{
+								boolplanmodifier.UseStateForUnknown(),
+							},
+						},
+						"ts_use_audio_rendition_group": schema.BoolAttribute{
+							Optional: true,
+							Computed: true,
+							PlanModifiers: []planmodifier.Bool{
+								boolplanmodifier.UseStateForUnknown(),
+							},
+						},
+					},
+					Blocks: map[string]schema.Block{
+						"encryption": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[encryptionModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"constant_initialization_vector": schema.StringAttribute{
+										Optional: true,
+										Validators: []validator.String{
+											stringvalidator.LengthBetween(32, 32),
+										},
+									},
+									"key_rotation_interval_seconds": schema.Int64Attribute{
+										Optional: true,
+										Computed: true,
+										PlanModifiers: []planmodifier.Int64{
+											int64planmodifier.UseStateForUnknown(),
+										},
+										Validators: []validator.Int64{
+											int64validator.Between(300, 31536000),
+										},
+									},
+								},
+								Blocks: map[string]schema.Block{
+									"encryption_method": schema.ListNestedBlock{
+										CustomType: fwtypes.NewListNestedObjectTypeOf[encryptionMethodModel](ctx),
+										Validators: []validator.List{
+											listvalidator.IsRequired(),
+											listvalidator.SizeAtLeast(1),
+											listvalidator.SizeAtMost(1),
+										},
+										NestedObject: schema.NestedBlockObject{
+											Attributes: map[string]schema.Attribute{
+												"cmaf_encryption_method": schema.StringAttribute{
+													CustomType: fwtypes.StringEnumType[awstypes.CmafEncryptionMethod](),
+													Optional:   true,
+												},
+												"ts_encryption_method": schema.StringAttribute{
+													CustomType: fwtypes.StringEnumType[awstypes.TsEncryptionMethod](),
+													Optional:   true,
+												},
+											},
+										},
+									},
+									"speke_key_provider": schema.ListNestedBlock{
+										CustomType: fwtypes.NewListNestedObjectTypeOf[spekeKeyProviderModel](ctx),
+										Validators: []validator.List{
+											listvalidator.IsRequired(),
+											listvalidator.SizeAtLeast(1),
+											listvalidator.SizeAtMost(1),
+										},
+										NestedObject: schema.NestedBlockObject{
+											Attributes: map[string]schema.Attribute{
+												"drm_systems": schema.SetAttribute{
+													CustomType:  fwtypes.SetOfStringType,
+													ElementType: types.StringType,
+													Required:    true,
+													Validators: []validator.Set{
+														setvalidator.SizeBetween(1, 4),
+														setvalidator.ValueStringsAre(stringvalidator.OneOf(enum.Values[awstypes.DrmSystem]()...)),
+													},
+												},
+												names.AttrResourceID: schema.StringAttribute{
+													Required: true,
+													Validators: []validator.String{
+														stringvalidator.LengthBetween(1, 256),
+													},
+												},
+												names.AttrRoleARN: schema.StringAttribute{
+													CustomType: fwtypes.ARNType,
+													Required:   true,
+												},
+												names.AttrURL: schema.StringAttribute{
+													Required: true,
+													Validators: []validator.String{
+														stringvalidator.LengthBetween(1, 1024),
+													},
+												},
+											},
+											Blocks: map[string]schema.Block{
+												"encryption_contract_configuration": schema.ListNestedBlock{
+													CustomType: fwtypes.NewListNestedObjectTypeOf[encryptionContractConfigurationModel](ctx),
+													Validators: []validator.List{
+														listvalidator.IsRequired(),
+														listvalidator.SizeAtLeast(1),
+														listvalidator.SizeAtMost(1),
+													},
+													NestedObject: schema.NestedBlockObject{
+														Attributes: map[string]schema.Attribute{
+															"preset_speke20_audio": schema.StringAttribute{
+																CustomType: fwtypes.StringEnumType[awstypes.PresetSpeke20Audio](),
+																Required:   true,
+															},
+															"preset_speke20_video": schema.StringAttribute{
+																CustomType: fwtypes.StringEnumType[awstypes.PresetSpeke20Video](),
+																Required:   true,
+															},
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"scte": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[scteModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"scte_filter": schema.SetAttribute{
+										CustomType:  fwtypes.SetOfStringType,
+										ElementType: types.StringType,
+										Optional:    true,
+										Validators: []validator.Set{
+											setvalidator.ValueStringsAre(stringvalidator.OneOf(enum.Values[awstypes.ScteFilter]()...)),
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *originEndpointResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data originEndpointResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().MediaPackageV2Client(ctx)
+
+	input := &mediapackagev2.CreateOriginEndpointInput{}
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Additional fields.
+	input.ClientToken = aws.String(sdkid.UniqueId())
+	input.Tags = getTagsIn(ctx)
+
+	output, err := conn.CreateOriginEndpoint(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating MediaPackage v2 Origin Endpoint (%s)", data.OriginEndpointName.ValueString()), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	response.Diagnostics.Append(data.flatten(ctx, output, false)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	data.setID()
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *originEndpointResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data originEndpointResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if err := data.InitFromID(); err != nil {
+		response.Diagnostics.AddError("parsing resource ID", err.Error())
+
+		return
+	}
+
+	conn := r.Meta().MediaPackageV2Client(ctx)
+
+	output, err := findOriginEndpointByThreePartKey(ctx, conn, data.ChannelGroupName.ValueString(), data.ChannelName.ValueString(), data.OriginEndpointName.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading MediaPackage v2 Origin Endpoint (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	// A null container type means the resource is being imported.
+	response.Diagnostics.Append(data.flatten(ctx, output, data.ContainerType.IsNull())...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	setTagsOut(ctx, output.Tags)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *originEndpointResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new originEndpointResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().MediaPackageV2Client(ctx)
+
+	if !new.DashManifests.Equal(old.DashManifests) ||
+		!new.Description.Equal(old.Description) ||
+		!new.HLSManifests.Equal(old.HLSManifests) ||
+		!new.LowLatencyHLSManifests.Equal(old.LowLatencyHLSManifests) ||
+		!new.Segment.Equal(old.Segment) ||
+		!new.StartoverWindowSeconds.Equal(old.StartoverWindowSeconds) {
+		input := &mediapackagev2.UpdateOriginEndpointInput{}
+		response.Diagnostics.Append(fwflex.Expand(ctx, new, input)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		output, err := conn.UpdateOriginEndpoint(ctx, input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating MediaPackage v2 Origin Endpoint (%s)", new.ID.ValueString()), err.Error())
+
+			return
+		}
+
+		response.Diagnostics.Append(new.flatten(ctx, output, false)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+	} else {
+		new.DashManifests = old.DashManifests
+		new.HLSManifests = old.HLSManifests
+		new.LowLatencyHLSManifests = old.LowLatencyHLSManifests
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *originEndpointResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data originEndpointResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().MediaPackageV2Client(ctx)
+
+	_, err := conn.DeleteOriginEndpoint(ctx, &mediapackagev2.DeleteOriginEndpointInput{
+		ChannelGroupName:   aws.String(data.ChannelGroupName.ValueString()),
+		ChannelName:        aws.String(data.ChannelName.ValueString()),
+		OriginEndpointName: aws.String(data.OriginEndpointName.ValueString()),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting MediaPackage v2 Origin Endpoint (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func (r *originEndpointResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	r.SetTagsAll(ctx, request, response)
+}
+
+func findOriginEndpointByThreePartKey(ctx context.Context, conn *mediapackagev2.Client, channelGroupName, channelName, originEndpointName string) (*mediapackagev2.GetOriginEndpointOutput, error) {
+	input := &mediapackagev2.GetOriginEndpointInput{
+		ChannelGroupName:   aws.String(channelGroupName),
+		ChannelName:        aws.String(channelName),
+		OriginEndpointName: aws.String(originEndpointName),
+	}
+
+	output, err := conn.GetOriginEndpoint(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+type originEndpointResourceModel struct {
+	ARN                    types.String                                       `tfsdk:"arn"`
+	ChannelGroupName       types.String                                       `tfsdk:"channel_group_name"`
+	ChannelName            types.String                                       `tfsdk:"channel_name"`
+	ContainerType          fwtypes.StringEnum[awstypes.ContainerType]         `tfsdk:"container_type"`
+	CreatedAt              timetypes.RFC3339                                  `tfsdk:"created_at"`
+	DashManifests          fwtypes.ListNestedObjectValueOf[dashManifestModel] `tfsdk:"dash_manifest"`
+	Description            types.String                                       `tfsdk:"description"`
+	HLSManifests           fwtypes.ListNestedObjectValueOf[hlsManifestModel]  `tfsdk:"hls_manifest"`
+	ID                     types.String                                       `tfsdk:"id"`
+	LowLatencyHLSManifests fwtypes.ListNestedObjectValueOf[hlsManifestModel]  `tfsdk:"low_latency_hls_manifest"`
+	OriginEndpointName     types.String                                       `tfsdk:"name"`
+	Segment                fwtypes.ListNestedObjectValueOf[segmentModel]      `tfsdk:"segment"`
+	StartoverWindowSeconds types.Int64                                        `tfsdk:"startover_window_seconds"`
+	Tags                   types.Map                                          `tfsdk:"tags"`
+	TagsAll                types.Map                                          `tfsdk:"tags_all"`
+}
+
+// flatten copies an API response into the model.
+// The service always returns segment settings, so they are only tracked when configured or when importing.
+func (data *originEndpointResourceModel) flatten(ctx context.Context, apiObject any, importing bool) diag.Diagnostics {
+	segment := data.Segment
+
+	diags := fwflex.Flatten(ctx, apiObject, data)
+	if diags.HasError() {
+		return diags
+	}
+
+	if !importing && len(segment.Elements()) == 0 {
+		data.Segment = segment
+	}
+
+	return diags
+}
+
+const (
+	originEndpointResourceIDPartCount = 3
+)
+
+func (data *originEndpointResourceModel) InitFromID() error {
+	parts, err := flex.ExpandResourceId(data.ID.ValueString(), originEndpointResourceIDPartCount, false)
+	if err != nil {
+		return err
+	}
+
+	data.ChannelGroupName = types.StringValue(parts[0])
+	data.ChannelName = types.StringValue(parts[1])
+	data.OriginEndpointName = types.StringValue(parts[2])
+
+	return nil
+}
+
+func (data *originEndpointResourceModel) setID() {
+	data.ID = types.StringValue(errs.Must(flex.FlattenResourceId([]string{data.ChannelGroupName.ValueString(), data.ChannelName.ValueString(), data.OriginEndpointName.ValueString()}, originEndpointResourceIDPartCount, false)))
+}
+
+type dashManifestModel struct {
+	DrmSignaling                      fwtypes.StringEnum[awstypes.DashDrmSignaling]             `tfsdk:"drm_signaling"`
+	FilterConfiguration               fwtypes.ListNestedObjectValueOf[filterConfigurationModel] `tfsdk:"filter_configuration"`
+	ManifestName                      types.String                                              `tfsdk:"manifest_name"`
+	ManifestWindowSeconds             types.Int64                                               `tfsdk:"manifest_window_seconds"`
+	MinBufferTimeSeconds              types.Int64                                               `tfsdk:"min_buffer_time_seconds"`
+	MinUpdatePeriodSeconds            types.Int64                                               `tfsdk:"min_update_period_seconds"`
+	PeriodTriggers                    fwtypes.SetValueOf[types.String]                          `tfsdk:"period_triggers"`
+	ScteDash                          fwtypes.ListNestedObjectValueOf[scteDASHModel]            `tfsdk:"scte_dash"`
+	SegmentTemplateFormat             fwtypes.StringEnum[awstypes.DashSegmentTemplateFormat]    `tfsdk:"segment_template_format"`
+	SuggestedPresentationDelaySeconds types.Int64                                               `tfsdk:"suggested_presentation_delay_seconds"`
+	URL                               types.String                                              `tfsdk:"url"`
+	UtcTiming                         fwtypes.ListNestedObjectValueOf[dashUTCTimingModel]       `tfsdk:"utc_timing"`
+}
+
+type hlsManifestModel struct {
+	ChildManifestName              types.String                                              `tfsdk:"child_manifest_name"`
+	FilterConfiguration            fwtypes.ListNestedObjectValueOf[filterConfigurationModel] `tfsdk:"filter_configuration"`
+	ManifestName                   types.String                                              `tfsdk:"manifest_name"`
+	ManifestWindowSeconds          types.Int64                                               `tfsdk:"manifest_window_seconds"`
+	ProgramDateTimeIntervalSeconds types.Int64                                               `tfsdk:"program_date_time_interval_seconds"`
+	ScteHls                        fwtypes.ListNestedObjectValueOf[scteHLSModel]             `tfsdk:"scte_hls"`
+	URL                            types.String                                              `tfsdk:"url"`
+}
+
+type filterConfigurationModel struct {
+	End              timetypes.RFC3339 `tfsdk:"end"`
+	ManifestFilter   types.String      `tfsdk:"manifest_filter"`
+	Start            timetypes.RFC3339 `tfsdk:"start"`
+	TimeDelaySeconds types.Int64       `tfsdk:"time_delay_seconds"`
+}
+
+type scteDASHModel struct {
+	AdMarkerDash fwtypes.StringEnum[awstypes.AdMarkerDash] `tfsdk:"ad_marker_dash"`
+}
+
+type scteHLSModel struct {
+	AdMarkerHls fwtypes.StringEnum[awstypes.AdMarkerHls] `tfsdk:"ad_marker_hls"`
+}
+
+type dashUTCTimingModel struct {
+	TimingMode   fwtypes.StringEnum[awstypes.DashUtcTimingMode] `tfsdk:"timing_mode"`
+	TimingSource types.String                                   `tfsdk:"timing_source"`
+}
+
+type segmentModel struct {
+	Encryption               fwtypes.ListNestedObjectValueOf[encryptionModel] `tfsdk:"encryption"`
+	IncludeIframeOnlyStreams types.Bool                                       `tfsdk:"include_iframe_only_streams"`
+	Scte                     fwtypes.ListNestedObjectValueOf[scteModel]       `tfsdk:"scte"`
+	SegmentDurationSeconds   types.Int64                                      `tfsdk:"segment_duration_seconds"`
+	SegmentName              types.String                                     `tfsdk:"segment_name"`
+	TsIncludeDvbSubtitles    types.Bool                                       `tfsdk:"ts_include_dvb_subtitles"`
+	TsUseAudioRenditionGroup types.Bool                                       `tfsdk:"ts_use_audio_rendition_group"`
+}
+
+type encryptionModel struct {
+	ConstantInitializationVector types.String                                           `tfsdk:"constant_initialization_vector"`
+	EncryptionMethod             fwtypes.ListNestedObjectValueOf[encryptionMethodModel] `tfsdk:"encryption_method"`
+	KeyRotationIntervalSeconds   types.Int64                                            `tfsdk:"key_rotation_interval_seconds"`
+	SpekeKeyProvider             fwtypes.ListNestedObjectValueOf[spekeKeyProviderModel] `tfsdk:"speke_key_provider"`
+}
+
+type encryptionMethodModel struct {
+	CmafEncryptionMethod fwtypes.StringEnum[awstypes.CmafEncryptionMethod] `tfsdk:"cmaf_encryption_method"`
+	TsEncryptionMethod   fwtypes.StringEnum[awstypes.TsEncryptionMethod]   `tfsdk:"ts_encryption_method"`
+}
+
+type spekeKeyProviderModel struct {
+	DrmSystems                      fwtypes.SetValueOf[types.String]                                      `tfsdk:"drm_systems"`
+	EncryptionContractConfiguration fwtypes.ListNestedObjectValueOf[encryptionContractConfigurationModel] `tfsdk:"encryption_contract_configuration"`
+	ResourceID                      types.String                                                          `tfsdk:"resource_id"`
+	RoleARN                         fwtypes.ARN                                                           `tfsdk:"role_arn"`
+	URL                             types.String                                                          `tfsdk:"url"`
+}
+
+type encryptionContractConfigurationModel struct {
+	PresetSpeke20Audio fwtypes.StringEnum[awstypes.PresetSpeke20Audio] `tfsdk:"preset_speke20_audio"`
+	PresetSpeke20Video fwtypes.StringEnum[awstypes.PresetSpeke20Video] `tfsdk:"preset_speke20_video"`
+}
+
+type scteModel struct {
+	ScteFilter fwtypes.SetValueOf[types.String] `tfsdk:"scte_filter"`
+}
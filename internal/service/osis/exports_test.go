@@ -7,5 +7,7 @@ package osis
 var (
 	ResourcePipeline = newPipelineResource
 
-	FindPipelineByName = findPipelineByName
+	FindPipelineByName               = findPipelineByName
+	PipelineConfigurationMessageLine = pipelineConfigurationMessageLine
+	PipelineConfigurationSyntaxError = pipelineConfigurationSyntaxError
 )
@@ -17,6 +17,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
@@ -85,7 +87,8 @@ func (r *pipelineResource) Schema(ctx context.Context, request resource.SchemaRe
 			},
 			"pipeline_arn": framework.ARNAttributeComputedOnly(),
 			"pipeline_configuration_body": schema.StringAttribute{
-				Required: true,
+				CustomType: pipelineConfigurationBodyType{},
+				Required:   true,
 				Validators: []validator.String{
 					stringvalidator.LengthBetween(1, 24000),
 				},
@@ -370,6 +373,72 @@ func (r *pipelineResource) Delete(ctx context.Context, request resource.DeleteRe
 
 func (r *pipelineResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
 	r.SetTagsAll(ctx, request, response)
+
+	if request.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan, state pipelineConfigurationBody
+	response.Diagnostics.Append(request.Plan.GetAttribute(ctx, path.Root("pipeline_configuration_body"), &plan)...)
+	if !request.State.Raw.IsNull() {
+		response.Diagnostics.Append(request.State.GetAttribute(ctx, path.Root("pipeline_configuration_body"), &state)...)
+	}
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Validate new or changed configurations now rather than after the pipeline fails to create.
+	if plan.IsUnknown() || plan.IsNull() || plan.Equal(state) {
+		return
+	}
+
+	response.Diagnostics.Append(r.validatePipelineConfiguration(ctx, plan.ValueString())...)
+}
+
+// validatePipelineConfiguration checks the pipeline configuration for YAML syntax errors and then with the
+// ValidatePipeline API, returning an error diagnostic, with the line number where known, for each problem found.
+func (r *pipelineResource) validatePipelineConfiguration(ctx context.Context, body string) diag.Diagnostics {
+	var diags diag.Diagnostics
+	attrPath := path.Root("pipeline_configuration_body")
+
+	if message := pipelineConfigurationSyntaxError(body); message != "" {
+		diags.AddAttributeError(attrPath, "Invalid OpenSearch Ingestion Pipeline configuration", message)
+
+		return diags
+	}
+
+	conn := r.Meta().OpenSearchIngestionClient(ctx)
+
+	input := &osis.ValidatePipelineInput{
+		PipelineConfigurationBody: aws.String(body),
+	}
+
+	output, err := conn.ValidatePipeline(ctx, input)
+
+	if err != nil {
+		diags.AddAttributeWarning(attrPath, "Unable to validate OpenSearch Ingestion Pipeline configuration", err.Error())
+
+		return diags
+	}
+
+	if aws.ToBool(output.IsValid) {
+		return diags
+	}
+
+	for _, v := range output.Errors {
+		message := aws.ToString(v.Message)
+		if line := pipelineConfigurationMessageLine(body, message); line > 0 && !pipelineConfigurationLineRegexp.MatchString(message) {
+			message = fmt.Sprintf("line %d: %s", line, message)
+		}
+
+		diags.AddAttributeError(attrPath, "Invalid OpenSearch Ingestion Pipeline configuration", message)
+	}
+
+	if !diags.HasError() {
+		diags.AddAttributeError(attrPath, "Invalid OpenSearch Ingestion Pipeline configuration", "The pipeline configuration failed validation.")
+	}
+
+	return diags
 }
 
 func findPipelineByName(ctx context.Context, conn *osis.Client, name string) (*awstypes.Pipeline, error) {
@@ -491,7 +560,7 @@ type pipelineResourceModel struct {
 	MaxUnits                  types.Int64                                                   `tfsdk:"max_units"`
 	MinUnits                  types.Int64                                                   `tfsdk:"min_units"`
 	PipelineARN               types.String                                                  `tfsdk:"pipeline_arn"`
-	PipelineConfigurationBody types.String                                                  `tfsdk:"pipeline_configuration_body"`
+	PipelineConfigurationBody pipelineConfigurationBody                                     `tfsdk:"pipeline_configuration_body"`
 	PipelineName              types.String                                                  `tfsdk:"pipeline_name"`
 	Tags                      types.Map                                                     `tfsdk:"tags"`
 	TagsAll                   types.Map                                                     `tfsdk:"tags_all"`
@@ -0,0 +1,83 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package osis
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/osis"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource(name="Pipeline Blueprints")
+func newPipelineBlueprintsDataSource(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &pipelineBlueprintsDataSource{}, nil
+}
+
+type pipelineBlueprintsDataSource struct {
+	framework.DataSourceWithConfigure
+}
+
+func (d *pipelineBlueprintsDataSource) Metadata(_ context.Context, request datasource.MetadataRequest, response *datasource.MetadataResponse) {
+	response.TypeName = "aws_osis_pipeline_blueprints"
+}
+
+func (d *pipelineBlueprintsDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"blueprints": schema.ListAttribute{
+				CustomType:  fwtypes.NewListNestedObjectTypeOf[pipelineBlueprintSummaryModel](ctx),
+				ElementType: fwtypes.NewObjectTypeOf[pipelineBlueprintSummaryModel](ctx),
+				Computed:    true,
+			},
+			names.AttrID: framework.IDAttribute(),
+		},
+	}
+}
+
+func (d *pipelineBlueprintsDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data pipelineBlueprintsDataSourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := d.Meta().OpenSearchIngestionClient(ctx)
+
+	output, err := conn.ListPipelineBlueprints(ctx, &osis.ListPipelineBlueprintsInput{})
+
+	if err != nil {
+		response.Diagnostics.AddError("listing OpenSearch Ingestion Pipeline Blueprints", err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	data.ID = types.StringValue(d.Meta().Region)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+type pipelineBlueprintsDataSourceModel struct {
+	Blueprints fwtypes.ListNestedObjectValueOf[pipelineBlueprintSummaryModel] `tfsdk:"blueprints"`
+	ID         types.String                                                   `tfsdk:"id"`
+}
+
+type pipelineBlueprintSummaryModel struct {
+	BlueprintName      types.String `tfsdk:"blueprint_name"`
+	DisplayDescription types.String `tfsdk:"display_description"`
+	DisplayName        types.String `tfsdk:"display_name"`
+	Service            types.String `tfsdk:"service"`
+	UseCase            types.String `tfsdk:"use_case"`
+}
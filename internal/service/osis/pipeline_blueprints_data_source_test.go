@@ -0,0 +1,42 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package osis_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccOpenSearchIngestionPipelineBlueprintsDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_osis_pipeline_blueprints.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.OpenSearchIngestionEndpointID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.OpenSearchIngestionServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPipelineBlueprintsDataSourceConfig_basic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, names.AttrID),
+					acctest.CheckResourceAttrGreaterThanValue(dataSourceName, "blueprints.#", 0),
+					resource.TestCheckResourceAttrSet(dataSourceName, "blueprints.0.blueprint_name"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "blueprints.0.display_name"),
+				),
+			},
+		},
+	})
+}
+
+const testAccPipelineBlueprintsDataSourceConfig_basic = `
+data "aws_osis_pipeline_blueprints" "test" {}
+`
@@ -0,0 +1,202 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package osis
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"gopkg.in/yaml.v3"
+)
+
+var (
+	_ basetypes.StringTypable                    = (*pipelineConfigurationBodyType)(nil)
+	_ basetypes.StringValuableWithSemanticEquals = (*pipelineConfigurationBody)(nil)
+)
+
+// pipelineConfigurationBodyType is a YAML (or JSON) pipeline configuration whose formatting is not significant.
+type pipelineConfigurationBodyType struct {
+	basetypes.StringType
+}
+
+func (t pipelineConfigurationBodyType) Equal(o attr.Type) bool {
+	other, ok := o.(pipelineConfigurationBodyType)
+	if !ok {
+		return false
+	}
+
+	return t.StringType.Equal(other.StringType)
+}
+
+func (pipelineConfigurationBodyType) String() string {
+	return "PipelineConfigurationBodyType"
+}
+
+func (t pipelineConfigurationBodyType) ValueFromString(_ context.Context, in types.String) (basetypes.StringValuable, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if in.IsNull() {
+		return pipelineConfigurationBodyNull(), diags
+	}
+	if in.IsUnknown() {
+		return pipelineConfigurationBodyUnknown(), diags
+	}
+
+	return pipelineConfigurationBodyValue(in.ValueString()), diags
+}
+
+func (t pipelineConfigurationBodyType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	stringValue, ok := attrValue.(basetypes.StringValue)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	stringValuable, diags := t.ValueFromString(ctx, stringValue)
+	if diags.HasError() {
+		return nil, fmt.Errorf("unexpected error converting StringValue to StringValuable: %v", diags)
+	}
+
+	return stringValuable, nil
+}
+
+func (pipelineConfigurationBodyType) ValueType(context.Context) attr.Value {
+	return pipelineConfigurationBody{}
+}
+
+type pipelineConfigurationBody struct {
+	basetypes.StringValue
+}
+
+func (v pipelineConfigurationBody) Equal(o attr.Value) bool {
+	other, ok := o.(pipelineConfigurationBody)
+	if !ok {
+		return false
+	}
+
+	return v.StringValue.Equal(other.StringValue)
+}
+
+func (pipelineConfigurationBody) Type(context.Context) attr.Type {
+	return pipelineConfigurationBodyType{}
+}
+
+func (v pipelineConfigurationBody) StringSemanticEquals(ctx context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(pipelineConfigurationBody)
+	if !ok {
+		return false, diags
+	}
+
+	return verify.JSONOrYAMLStringsEquivalent(v.ValueString(), newValue.ValueString()), diags
+}
+
+func pipelineConfigurationBodyNull() pipelineConfigurationBody {
+	return pipelineConfigurationBody{StringValue: basetypes.NewStringNull()}
+}
+
+func pipelineConfigurationBodyUnknown() pipelineConfigurationBody {
+	return pipelineConfigurationBody{StringValue: basetypes.NewStringUnknown()}
+}
+
+func pipelineConfigurationBodyValue(value string) pipelineConfigurationBody {
+	return pipelineConfigurationBody{StringValue: basetypes.NewStringValue(value)}
+}
+
+var (
+	pipelineConfigurationLineRegexp        = regexache.MustCompile(`(?i)\bline[: ]+(\d+)`)
+	pipelineConfigurationPathRegexp        = regexache.MustCompile(`\$((?:\.[^\s.\[\]:,'"]+|\[\d+\])+)`)
+	pipelineConfigurationPathSegmentRegexp = regexache.MustCompile(`\.[^.\[]+|\[\d+\]`)
+)
+
+// pipelineConfigurationSyntaxError returns a description of any YAML syntax error in the pipeline configuration,
+// prefixed with the offending line number where the parser reports one.
+func pipelineConfigurationSyntaxError(body string) string {
+	var root yaml.Node
+
+	if err := yaml.Unmarshal([]byte(body), &root); err != nil {
+		return strings.TrimPrefix(err.Error(), "yaml: ")
+	}
+
+	return ""
+}
+
+// pipelineConfigurationMessageLine returns the line number in the pipeline configuration
+// that a validation message refers to, or 0 if it can't be determined.
+// The line is taken from an explicit "line N" reference or, failing that, from a JSON path
+// such as "$.log-pipeline.sink[0].opensearch" which is resolved against the configuration.
+func pipelineConfigurationMessageLine(body, message string) int {
+	if m := pipelineConfigurationLineRegexp.FindStringSubmatch(message); m != nil {
+		if line, err := strconv.Atoi(m[1]); err == nil {
+			return line
+		}
+	}
+
+	m := pipelineConfigurationPathRegexp.FindStringSubmatch(message)
+	if m == nil {
+		return 0
+	}
+
+	var root yaml.Node
+
+	if err := yaml.Unmarshal([]byte(body), &root); err != nil {
+		return 0
+	}
+
+	return yamlNodeLine(&root, m[1])
+}
+
+// yamlNodeLine resolves a JSON path suffix (".a.b[0].c") against a YAML document and returns
+// the line number of the deepest node that could be found.
+func yamlNodeLine(root *yaml.Node, jsonPath string) int {
+	node := root
+	if node.Kind == yaml.DocumentNode && len(node.Content) > 0 {
+		node = node.Content[0]
+	}
+
+	line := 0
+
+	for _, segment := range pipelineConfigurationPathSegmentRegexp.FindAllString(jsonPath, -1) {
+		var next *yaml.Node
+
+		switch {
+		case strings.HasPrefix(segment, "[") && node.Kind == yaml.SequenceNode:
+			if i, err := strconv.Atoi(strings.Trim(segment, "[]")); err == nil && i < len(node.Content) {
+				next = node.Content[i]
+				line = next.Line
+			}
+		case strings.HasPrefix(segment, ".") && node.Kind == yaml.MappingNode:
+			key := strings.TrimPrefix(segment, ".")
+			for i := 0; i+1 < len(node.Content); i += 2 {
+				if node.Content[i].Value == key {
+					next = node.Content[i+1]
+					line = node.Content[i].Line
+					break
+				}
+			}
+		}
+
+		if next == nil {
+			break
+		}
+
+		node = next
+	}
+
+	return line
+}
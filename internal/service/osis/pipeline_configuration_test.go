@@ -0,0 +1,106 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package osis_test
+
+import (
+	"testing"
+
+	tfosis "github.com/hashicorp/terraform-provider-aws/internal/service/osis"
+)
+
+const testPipelineConfigurationBody = `version: "2"
+log-pipeline:
+  source:
+    http:
+      path: "/log/ingest"
+  processor:
+    - date:
+        from_time_received: true
+        destination: "@timestamp"
+  sink:
+    - opensearch:
+        hosts: ["https://search-example.us-east-1.es.amazonaws.com"]
+        index: "application_logs"
+`
+
+func TestPipelineConfigurationSyntaxError(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		body     string
+		expected string
+	}{
+		"valid": {
+			body: testPipelineConfigurationBody,
+		},
+		"mapping in scalar": {
+			body:     "version: \"2\"\ntest-pipeline:\n  source:\n    http: path: \"/test\"\n",
+			expected: "line 4: mapping values are not allowed in this context",
+		},
+		"unterminated flow sequence": {
+			body:     "version: \"2\"\ntest-pipeline:\n  sink: [\n",
+			expected: "line 3: did not find expected node content",
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := tfosis.PipelineConfigurationSyntaxError(testCase.body); got != testCase.expected {
+				t.Errorf("got %q, expected %q", got, testCase.expected)
+			}
+		})
+	}
+}
+
+func TestPipelineConfigurationMessageLine(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		message  string
+		expected int
+	}{
+		"no location": {
+			message:  "Pipeline configuration is invalid",
+			expected: 0,
+		},
+		"explicit line": {
+			message:  "Unexpected token at line 7, column 3",
+			expected: 7,
+		},
+		"top-level path": {
+			message:  "$.log-pipeline: sink is required",
+			expected: 2,
+		},
+		"nested path": {
+			message:  "$.log-pipeline.source.http.path: must start with '/'",
+			expected: 5,
+		},
+		"sequence path": {
+			message:  "$.log-pipeline.sink[0].opensearch.index: invalid index name",
+			expected: 13,
+		},
+		"partially resolvable path": {
+			message:  "$.log-pipeline.processor[0].date.unknown_setting: property is not allowed",
+			expected: 7,
+		},
+		"out of range index": {
+			message:  "$.log-pipeline.sink[3]: invalid sink",
+			expected: 10,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := tfosis.PipelineConfigurationMessageLine(testPipelineConfigurationBody, testCase.message); got != testCase.expected {
+				t.Errorf("got %d, expected %d", got, testCase.expected)
+			}
+		})
+	}
+}
@@ -63,6 +63,64 @@ func TestAccOpenSearchIngestionPipeline_basic(t *testing.T) {
 	})
 }
 
+func TestAccOpenSearchIngestionPipeline_configurationFormatting(t *testing.T) {
+	ctx := acctest.Context(t)
+	var pipeline types.Pipeline
+	rName := fmt.Sprintf("%s-%s", acctest.ResourcePrefix, sdkacctest.RandString(10))
+	resourceName := "aws_osis_pipeline.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.OpenSearchIngestionEndpointID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.OpenSearchIngestionServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPipelineDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPipelineConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPipelineExists(ctx, resourceName, &pipeline),
+				),
+			},
+			{
+				Config:   testAccPipelineConfig_reformatted(rName),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestAccOpenSearchIngestionPipeline_invalidConfiguration(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := fmt.Sprintf("%s-%s", acctest.ResourcePrefix, sdkacctest.RandString(10))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.OpenSearchIngestionEndpointID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.OpenSearchIngestionServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPipelineDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccPipelineConfig_invalidSyntax(rName),
+				PlanOnly:    true,
+				ExpectError: regexache.MustCompile(`line 4`),
+			},
+			{
+				Config:      testAccPipelineConfig_missingSink(rName),
+				PlanOnly:    true,
+				ExpectError: regexache.MustCompile(`Invalid OpenSearch Ingestion Pipeline configuration`),
+			},
+		},
+	})
+}
+
 func TestAccOpenSearchIngestionPipeline_disappears(t *testing.T) {
 	ctx := acctest.Context(t)
 	var pipeline types.Pipeline
@@ -395,6 +453,89 @@ resource "aws_osis_pipeline" "test" {
 `, rName)
 }
 
+// testAccPipelineConfig_reformatted is testAccPipelineConfig_basic with the pipeline configuration
+// indented, quoted and commented differently.
+func testAccPipelineConfig_reformatted(rName string) string {
+	return fmt.Sprintf(`
+data "aws_region" "current" {}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [
+      {
+        Action = "sts:AssumeRole"
+        Effect = "Allow"
+        Sid    = ""
+        Principal = {
+          Service = "osis-pipelines.amazonaws.com"
+        }
+      },
+    ]
+  })
+}
+
+resource "aws_osis_pipeline" "test" {
+  pipeline_name               = %[1]q
+  pipeline_configuration_body = <<-EOT
+            # Test pipeline.
+            version: '2'
+            test-pipeline:
+                source:
+                    http:
+                        path: /test
+                sink:
+                - s3:
+                    aws:
+                        sts_role_arn: ${aws_iam_role.test.arn}
+                        region: ${data.aws_region.current.name}
+                    bucket: test
+                    threshold:
+                        event_collect_timeout: 60s
+                    codec:
+                        ndjson:
+        EOT
+  max_units                   = 1
+  min_units                   = 1
+}
+`, rName)
+}
+
+func testAccPipelineConfig_invalidSyntax(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_osis_pipeline" "test" {
+  pipeline_name               = %[1]q
+  pipeline_configuration_body = <<-EOT
+            version: "2"
+            test-pipeline:
+              source:
+                http: path: "/test"
+        EOT
+  max_units                   = 1
+  min_units                   = 1
+}
+`, rName)
+}
+
+func testAccPipelineConfig_missingSink(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_osis_pipeline" "test" {
+  pipeline_name               = %[1]q
+  pipeline_configuration_body = <<-EOT
+            version: "2"
+            test-pipeline:
+              source:
+                http:
+                  path: "/test"
+        EOT
+  max_units                   = 1
+  min_units                   = 1
+}
+`, rName)
+}
+
 func testAccPipelineConfig_tags1(rName string, key1, value1 string) string {
 	return fmt.Sprintf(`
 data "aws_region" "current" {}
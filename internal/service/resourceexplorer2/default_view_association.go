@@ -0,0 +1,175 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resourceexplorer2
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/service/resourceexplorer2"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Default View Association")
+func newResourceDefaultViewAssociation(context.Context) (resource.ResourceWithConfigure, error) {
+	return &resourceDefaultViewAssociation{}, nil
+}
+
+type resourceDefaultViewAssociation struct {
+	framework.ResourceWithConfigure
+	framework.WithNoUpdate
+}
+
+func (r *resourceDefaultViewAssociation) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_resourceexplorer2_default_view_association"
+}
+
+func (r *resourceDefaultViewAssociation) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrID: framework.IDAttribute(),
+			"view_arn": schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Required:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+func (r *resourceDefaultViewAssociation) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data defaultViewAssociationResourceModel
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().ResourceExplorer2Client(ctx)
+
+	input := &resourceexplorer2.AssociateDefaultViewInput{
+		ViewArn: flex.StringFromFramework(ctx, data.ViewARN),
+	}
+
+	_, err := conn.AssociateDefaultView(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("setting Resource Explorer View (%s) as the default", data.ViewARN.ValueString()), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.ID = types.StringValue(data.ViewARN.ValueString())
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *resourceDefaultViewAssociation) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data defaultViewAssociationResourceModel
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().ResourceExplorer2Client(ctx)
+
+	err := findDefaultViewAssociationByViewARN(ctx, conn, data.ID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Resource Explorer Default View Association (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	data.ViewARN = fwtypes.ARNValue(data.ID.ValueString())
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *resourceDefaultViewAssociation) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data defaultViewAssociationResourceModel
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().ResourceExplorer2Client(ctx)
+
+	// Don't remove a default view that has since been replaced by another view.
+	err := findDefaultViewAssociationByViewARN(ctx, conn, data.ID.ValueString())
+
+	if tfresource.NotFound(err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Resource Explorer Default View Association (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	tflog.Debug(ctx, "deleting Resource Explorer Default View Association", map[string]interface{}{
+		names.AttrID: data.ID.ValueString(),
+	})
+	_, err = conn.DisassociateDefaultView(ctx, &resourceexplorer2.DisassociateDefaultViewInput{})
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Resource Explorer Default View Association (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func (r *resourceDefaultViewAssociation) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root(names.AttrID), request, response)
+}
+
+type defaultViewAssociationResourceModel struct {
+	ID      types.String `tfsdk:"id"`
+	ViewARN fwtypes.ARN  `tfsdk:"view_arn"`
+}
+
+func findDefaultViewAssociationByViewARN(ctx context.Context, conn *resourceexplorer2.Client, viewARN string) error {
+	defaultViewARN, err := findDefaultViewARN(ctx, conn)
+
+	if err != nil {
+		return err
+	}
+
+	if defaultViewARN != viewARN {
+		return &retry.NotFoundError{
+			Message: fmt.Sprintf("default view is %q", defaultViewARN),
+		}
+	}
+
+	return nil
+}
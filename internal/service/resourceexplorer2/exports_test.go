@@ -5,8 +5,11 @@ package resourceexplorer2
 
 // Exports for use in tests only.
 var (
-	FindIndex     = findIndex
-	FindViewByARN = findViewByARN
-	ResourceIndex = newResourceIndex
-	ResourceView  = newResourceView
+	FindDefaultViewAssociationByViewARN = findDefaultViewAssociationByViewARN
+	FindIndex                           = findIndex
+	FindViewByARN                       = findViewByARN
+	IsAggregatorIndexCoolDownError      = isAggregatorIndexCoolDownError
+	ResourceDefaultViewAssociation      = newResourceDefaultViewAssociation
+	ResourceIndex                       = newResourceIndex
+	ResourceView                        = newResourceView
 )
@@ -12,6 +12,8 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/resourceexplorer2"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/resourceexplorer2/types"
 	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -107,22 +109,9 @@ func (r *resourceIndex) Create(ctx context.Context, request resource.CreateReque
 	}
 
 	if data.Type.ValueEnum() == awstypes.IndexTypeAggregator {
-		input := &resourceexplorer2.UpdateIndexTypeInput{
-			Arn:  flex.StringFromFramework(ctx, data.ID),
-			Type: awstypes.IndexTypeAggregator,
-		}
-
-		_, err := conn.UpdateIndexType(ctx, input)
-
-		if err != nil {
-			response.Diagnostics.AddError(fmt.Sprintf("updating Resource Explorer Index (%s)", data.ID.ValueString()), err.Error())
-
-			return
-		}
-
-		if _, err := waitIndexUpdated(ctx, conn, createTimeout); err != nil {
-			response.Diagnostics.AddError(fmt.Sprintf("waiting for Resource Explorer Index (%s) update", data.ID.ValueString()), err.Error())
+		response.Diagnostics.Append(updateIndexType(ctx, conn, arn, awstypes.IndexTypeAggregator, createTimeout)...)
 
+		if response.Diagnostics.HasError() {
 			return
 		}
 	}
@@ -188,23 +177,9 @@ func (r *resourceIndex) Update(ctx context.Context, request resource.UpdateReque
 	if !new.Type.Equal(old.Type) {
 		conn := r.Meta().ResourceExplorer2Client(ctx)
 
-		input := &resourceexplorer2.UpdateIndexTypeInput{
-			Arn:  flex.StringFromFramework(ctx, new.ARN),
-			Type: new.Type.ValueEnum(),
-		}
-
-		_, err := conn.UpdateIndexType(ctx, input)
-
-		if err != nil {
-			response.Diagnostics.AddError(fmt.Sprintf("updating Resource Explorer Index (%s)", new.ID.ValueString()), err.Error())
-
-			return
-		}
-
-		updateTimeout := r.UpdateTimeout(ctx, new.Timeouts)
-		if _, err := waitIndexUpdated(ctx, conn, updateTimeout); err != nil {
-			response.Diagnostics.AddError(fmt.Sprintf("waiting for Resource Explorer Index (%s) update", new.ID.ValueString()), err.Error())
+		response.Diagnostics.Append(updateIndexType(ctx, conn, new.ARN.ValueString(), new.Type.ValueEnum(), r.UpdateTimeout(ctx, new.Timeouts))...)
 
+		if response.Diagnostics.HasError() {
 			return
 		}
 	}
@@ -246,6 +221,28 @@ func (r *resourceIndex) Delete(ctx context.Context, request resource.DeleteReque
 
 func (r *resourceIndex) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
 	r.SetTagsAll(ctx, request, response)
+
+	if request.State.Raw.IsNull() || request.Plan.Raw.IsNull() {
+		return
+	}
+
+	var old, new fwtypes.StringEnum[awstypes.IndexType]
+
+	response.Diagnostics.Append(request.State.GetAttribute(ctx, path.Root(names.AttrType), &old)...)
+	response.Diagnostics.Append(request.Plan.GetAttribute(ctx, path.Root(names.AttrType), &new)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if old.ValueEnum() == awstypes.IndexTypeAggregator && new.ValueEnum() == awstypes.IndexTypeLocal {
+		response.Diagnostics.AddAttributeWarning(
+			path.Root(names.AttrType),
+			"Demoting Resource Explorer aggregator index",
+			fmt.Sprintf("Changing the index type to %[1]s turns off cross-Region search for the account. "+
+				"No index in the account can be promoted to %[2]s again until 24 hours after the demotion.", awstypes.IndexTypeLocal, awstypes.IndexTypeAggregator),
+		)
+	}
 }
 
 // See https://docs.aws.amazon.com/resource-explorer/latest/apireference/API_Index.html.
@@ -288,6 +285,93 @@ func findIndex(ctx context.Context, conn *resourceexplorer2.Client) (*resourceex
 	return output, nil
 }
 
+// findAggregatorIndex returns the account's aggregator index, which may be in any Region.
+func findAggregatorIndex(ctx context.Context, conn *resourceexplorer2.Client) (*awstypes.Index, error) {
+	input := &resourceexplorer2.ListIndexesInput{
+		Type: awstypes.IndexTypeAggregator,
+	}
+
+	paginator := resourceexplorer2.NewListIndexesPaginator(conn, input)
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		if len(page.Indexes) > 0 {
+			return &page.Indexes[0], nil
+		}
+	}
+
+	return nil, tfresource.NewEmptyResultError(input)
+}
+
+// updateIndexType promotes or demotes the specified index and waits for the change to complete.
+// Before promoting, it checks that no other Region already has the account's aggregator index.
+func updateIndexType(ctx context.Context, conn *resourceexplorer2.Client, arn string, indexType awstypes.IndexType, timeout time.Duration) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if indexType == awstypes.IndexTypeAggregator {
+		output, err := findAggregatorIndex(ctx, conn)
+
+		switch {
+		case tfresource.NotFound(err):
+		case err != nil:
+			diags.AddError("listing Resource Explorer aggregator indexes", err.Error())
+
+			return diags
+		case aws.ToString(output.Arn) != arn:
+			diags.AddError(
+				fmt.Sprintf("promoting Resource Explorer Index (%s) to %s", arn, indexType),
+				fmt.Sprintf("The account's aggregator index is in Region %s (%s). An account can have only one aggregator index; change that index's type to %s first.", aws.ToString(output.Region), aws.ToString(output.Arn), awstypes.IndexTypeLocal),
+			)
+
+			return diags
+		}
+	}
+
+	input := &resourceexplorer2.UpdateIndexTypeInput{
+		Arn:  aws.String(arn),
+		Type: indexType,
+	}
+
+	_, err := conn.UpdateIndexType(ctx, input)
+
+	if indexType == awstypes.IndexTypeAggregator && isAggregatorIndexCoolDownError(err) {
+		diags.AddError(
+			fmt.Sprintf("promoting Resource Explorer Index (%s) to %s", arn, indexType),
+			fmt.Sprintf("After an aggregator index is demoted to %s, no index in the account can be promoted to %s for 24 hours. "+
+				"If an aggregator index was demoted within the last 24 hours, retry once the cool-down has elapsed.\n\n%s", awstypes.IndexTypeLocal, indexType, err),
+		)
+
+		return diags
+	}
+
+	if err != nil {
+		diags.AddError(fmt.Sprintf("updating Resource Explorer Index (%s)", arn), err.Error())
+
+		return diags
+	}
+
+	if _, err := waitIndexUpdated(ctx, conn, timeout); err != nil {
+		diags.AddError(fmt.Sprintf("waiting for Resource Explorer Index (%s) update", arn), err.Error())
+
+		return diags
+	}
+
+	return diags
+}
+
+// isAggregatorIndexCoolDownError returns whether the error is the one returned when promoting an index
+// within 24 hours of demoting the account's previous aggregator index.
+func isAggregatorIndexCoolDownError(err error) bool {
+	const coolDownMessage = "24 hours"
+
+	return errs.IsAErrorMessageContains[*awstypes.ServiceQuotaExceededException](err, coolDownMessage) ||
+		errs.IsAErrorMessageContains[*awstypes.ConflictException](err, coolDownMessage)
+}
+
 func statusIndex(ctx context.Context, conn *resourceexplorer2.Client) retry.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		output, err := findIndex(ctx, conn)
@@ -9,6 +9,8 @@ import (
 	"testing"
 
 	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/resourceexplorer2/types"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -18,6 +20,46 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
+func TestIsAggregatorIndexCoolDownError(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		err      error
+		expected bool
+	}{
+		"nil": {},
+		"cool-down ServiceQuotaExceededException": {
+			err:      &awstypes.ServiceQuotaExceededException{Message: aws.String("You must wait 24 hours after demoting an aggregator index before promoting another index.")},
+			expected: true,
+		},
+		"cool-down ConflictException": {
+			err:      &awstypes.ConflictException{Message: aws.String("An aggregator index was demoted less than 24 hours ago.")},
+			expected: true,
+		},
+		"other ServiceQuotaExceededException": {
+			err: &awstypes.ServiceQuotaExceededException{Message: aws.String("The request failed because it exceeds a service quota.")},
+		},
+		"other ConflictException": {
+			err: &awstypes.ConflictException{Message: aws.String("The index is being updated.")},
+		},
+		"other error": {
+			err: &awstypes.ValidationException{Message: aws.String("24 hours")},
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := tfresourceexplorer2.IsAggregatorIndexCoolDownError(testCase.err), testCase.expected; got != want {
+				t.Errorf("IsAggregatorIndexCoolDownError() = %t, want %t", got, want)
+			}
+		})
+	}
+}
+
 func testAccIndex_basic(t *testing.T) {
 	ctx := acctest.Context(t)
 	resourceName := "aws_resourceexplorer2_index.test"
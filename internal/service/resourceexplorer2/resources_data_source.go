@@ -0,0 +1,151 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resourceexplorer2
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/resourceexplorer2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/resourceexplorer2/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource(name="Resources")
+func newDataSourceResources(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &dataSourceResources{}, nil
+}
+
+const (
+	DSNameResources = "Resources Data Source"
+)
+
+type dataSourceResources struct {
+	framework.DataSourceWithConfigure
+}
+
+func (d *dataSourceResources) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) { // nosemgrep:ci.meta-in-func-name
+	resp.TypeName = "aws_resourceexplorer2_resources"
+}
+
+func (d *dataSourceResources) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARNs: schema.ListAttribute{
+				CustomType:  fwtypes.ListOfStringType,
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			names.AttrID: framework.IDAttribute(),
+			"query_string": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(1280),
+				},
+			},
+			names.AttrResources: schema.ListAttribute{
+				CustomType:  fwtypes.NewListNestedObjectTypeOf[resourcesData](ctx),
+				ElementType: fwtypes.NewObjectTypeOf[resourcesData](ctx),
+				Computed:    true,
+			},
+			"view_arn": schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Optional:   true,
+				Computed:   true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(0, 1011),
+				},
+			},
+		},
+	}
+}
+
+func (d *dataSourceResources) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	conn := d.Meta().ResourceExplorer2Client(ctx)
+
+	var data dataSourceResourcesData
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.ID = types.StringValue(fmt.Sprintf("%s,%s", data.ViewARN.ValueString(), data.QueryString.ValueString()))
+
+	// An empty query string matches every resource visible through the view.
+	input := &resourceexplorer2.SearchInput{
+		QueryString: aws.String(data.QueryString.ValueString()),
+	}
+	if !data.ViewARN.IsNull() {
+		input.ViewArn = aws.String(data.ViewARN.ValueString())
+	}
+
+	viewARN, resources, err := findResources(ctx, conn, input)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.ResourceExplorer2, create.ErrActionReading, DSNameResources, data.ID.ValueString(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	out := &resourceexplorer2.SearchOutput{
+		Resources: resources,
+		ViewArn:   aws.String(viewARN),
+	}
+	resp.Diagnostics.Append(flex.Flatten(ctx, out, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	arns := make([]string, 0, len(resources))
+	for _, v := range resources {
+		arns = append(arns, aws.ToString(v.Arn))
+	}
+	data.ARNs = flex.FlattenFrameworkStringValueListOfString(ctx, arns)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+type dataSourceResourcesData struct {
+	ARNs        fwtypes.ListValueOf[types.String]              `tfsdk:"arns"`
+	ID          types.String                                   `tfsdk:"id"`
+	QueryString types.String                                   `tfsdk:"query_string"`
+	Resources   fwtypes.ListNestedObjectValueOf[resourcesData] `tfsdk:"resources"`
+	ViewARN     fwtypes.ARN                                    `tfsdk:"view_arn"`
+}
+
+// findResources returns every resource matching the search, following pagination to the end
+// of the results, together with the ARN of the view used.
+func findResources(ctx context.Context, conn *resourceexplorer2.Client, input *resourceexplorer2.SearchInput) (string, []awstypes.Resource, error) {
+	var viewARN string
+	var output []awstypes.Resource
+
+	paginator := resourceexplorer2.NewSearchPaginator(conn, input)
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+
+		if err != nil {
+			return "", nil, err
+		}
+
+		if viewARN == "" {
+			viewARN = aws.ToString(page.ViewArn)
+		}
+
+		output = append(output, page.Resources...)
+	}
+
+	return viewARN, output, nil
+}
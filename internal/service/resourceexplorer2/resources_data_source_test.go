@@ -0,0 +1,77 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resourceexplorer2_test
+
+import (
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func testAccResourcesDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_resourceexplorer2_resources.test"
+	viewResourceName := "aws_resourceexplorer2_view.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.ResourceExplorer2EndpointID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.ResourceExplorer2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             nil,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourcesDataSourceConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "view_arn", viewResourceName, names.AttrARN),
+					resource.TestCheckResourceAttrSet(dataSourceName, "arns.0"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "arns.#", dataSourceName, "resources.#"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "resources.0.arn"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "resources.0.last_reported_at"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "resources.0.owning_account_id"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "resources.0.region"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "resources.0.resource_type"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "resources.0.service"),
+				),
+			},
+		},
+	})
+}
+
+func testAccResourcesDataSourceConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_resourceexplorer2_index" "test" {
+  type = "LOCAL"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_resourceexplorer2_view" "test" {
+  depends_on = [aws_resourceexplorer2_index.test]
+
+  name = %[1]q
+
+  included_property {
+    name = "tags"
+  }
+}
+
+data "aws_resourceexplorer2_resources" "test" {
+  view_arn = aws_resourceexplorer2_view.test.arn
+}
+`, rName)
+}
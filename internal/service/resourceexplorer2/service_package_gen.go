@@ -16,6 +16,10 @@ type servicePackage struct{}
 
 func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*types.ServicePackageFrameworkDataSource {
 	return []*types.ServicePackageFrameworkDataSource{
+		{
+			Factory: newDataSourceResources,
+			Name:    "Resources",
+		},
 		{
 			Factory: newDataSourceSearch,
 			Name:    "Search",
@@ -25,6 +29,10 @@ func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*types.Serv
 
 func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.ServicePackageFrameworkResource {
 	return []*types.ServicePackageFrameworkResource{
+		{
+			Factory: newResourceDefaultViewAssociation,
+			Name:    "Default View Association",
+		},
 		{
 			Factory: newResourceIndex,
 			Name:    "Index",
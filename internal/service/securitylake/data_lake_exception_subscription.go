@@ -0,0 +1,232 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package securitylake
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/securitylake"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/securitylake/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Data Lake Exception Subscription")
+func newDataLakeExceptionSubscriptionResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &dataLakeExceptionSubscriptionResource{}
+
+	return r, nil
+}
+
+type dataLakeExceptionSubscriptionResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+}
+
+func (r *dataLakeExceptionSubscriptionResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_securitylake_data_lake_exception_subscription"
+}
+
+func (r *dataLakeExceptionSubscriptionResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"exception_time_to_live": schema.Int64Attribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			"notification_endpoint": schema.StringAttribute{
+				Required: true,
+			},
+			"subscription_protocol": schema.StringAttribute{
+				Required: true,
+			},
+		},
+	}
+}
+
+func (r *dataLakeExceptionSubscriptionResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data dataLakeExceptionSubscriptionResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().SecurityLakeClient(ctx)
+
+	input := &securitylake.CreateDataLakeExceptionSubscriptionInput{}
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := retryDataLakeConflictWithMutex(ctx, func() (*securitylake.CreateDataLakeExceptionSubscriptionOutput, error) {
+		return conn.CreateDataLakeExceptionSubscription(ctx, input)
+	})
+
+	if err != nil {
+		response.Diagnostics.AddError("creating Security Lake Data Lake Exception Subscription", err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.ID = types.StringValue(r.Meta().AccountID)
+
+	output, err := findDataLakeExceptionSubscription(ctx, conn)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Security Lake Data Lake Exception Subscription (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	data.ExceptionTimeToLive = fwflex.Int64ToFramework(ctx, output.ExceptionTimeToLive)
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *dataLakeExceptionSubscriptionResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data dataLakeExceptionSubscriptionResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().SecurityLakeClient(ctx)
+
+	output, err := findDataLakeExceptionSubscription(ctx, conn)
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Security Lake Data Lake Exception Subscription (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *dataLakeExceptionSubscriptionResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var new dataLakeExceptionSubscriptionResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().SecurityLakeClient(ctx)
+
+	input := &securitylake.UpdateDataLakeExceptionSubscriptionInput{}
+	response.Diagnostics.Append(fwflex.Expand(ctx, new, input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := retryDataLakeConflictWithMutex(ctx, func() (*securitylake.UpdateDataLakeExceptionSubscriptionOutput, error) {
+		return conn.UpdateDataLakeExceptionSubscription(ctx, input)
+	})
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("updating Security Lake Data Lake Exception Subscription (%s)", new.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	output, err := findDataLakeExceptionSubscription(ctx, conn)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Security Lake Data Lake Exception Subscription (%s)", new.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	new.ExceptionTimeToLive = fwflex.Int64ToFramework(ctx, output.ExceptionTimeToLive)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *dataLakeExceptionSubscriptionResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data dataLakeExceptionSubscriptionResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().SecurityLakeClient(ctx)
+
+	_, err := retryDataLakeConflictWithMutex(ctx, func() (*securitylake.DeleteDataLakeExceptionSubscriptionOutput, error) {
+		return conn.DeleteDataLakeExceptionSubscription(ctx, &securitylake.DeleteDataLakeExceptionSubscriptionInput{})
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Security Lake Data Lake Exception Subscription (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func findDataLakeExceptionSubscription(ctx context.Context, conn *securitylake.Client) (*securitylake.GetDataLakeExceptionSubscriptionOutput, error) {
+	input := &securitylake.GetDataLakeExceptionSubscriptionInput{}
+
+	output, err := conn.GetDataLakeExceptionSubscription(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || aws.ToString(output.NotificationEndpoint) == "" {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+type dataLakeExceptionSubscriptionResourceModel struct {
+	ExceptionTimeToLive  types.Int64  `tfsdk:"exception_time_to_live"`
+	ID                   types.String `tfsdk:"id"`
+	NotificationEndpoint types.String `tfsdk:"notification_endpoint"`
+	SubscriptionProtocol types.String `tfsdk:"subscription_protocol"`
+}
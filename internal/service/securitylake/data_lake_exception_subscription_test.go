@@ -0,0 +1,173 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package securitylake_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfsecuritylake "github.com/hashicorp/terraform-provider-aws/internal/service/securitylake"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func testAccDataLakeExceptionSubscription_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_securitylake_data_lake_exception_subscription.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.SecurityLake)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.SecurityLakeServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDataLakeExceptionSubscriptionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataLakeExceptionSubscriptionConfig_basic(acctest.DefaultEmailAddress),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDataLakeExceptionSubscriptionExists(ctx, resourceName),
+					resource.TestCheckResourceAttrSet(resourceName, "exception_time_to_live"),
+					acctest.CheckResourceAttrAccountID(resourceName, names.AttrID),
+					resource.TestCheckResourceAttr(resourceName, "notification_endpoint", acctest.DefaultEmailAddress),
+					resource.TestCheckResourceAttr(resourceName, "subscription_protocol", "email"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccDataLakeExceptionSubscription_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_securitylake_data_lake_exception_subscription.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.SecurityLake)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.SecurityLakeServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDataLakeExceptionSubscriptionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataLakeExceptionSubscriptionConfig_basic(acctest.DefaultEmailAddress),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDataLakeExceptionSubscriptionExists(ctx, resourceName),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfsecuritylake.ResourceDataLakeExceptionSubscription, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccDataLakeExceptionSubscription_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_securitylake_data_lake_exception_subscription.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.SecurityLake)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.SecurityLakeServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDataLakeExceptionSubscriptionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataLakeExceptionSubscriptionConfig_timeToLive(acctest.DefaultEmailAddress, 7),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDataLakeExceptionSubscriptionExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "exception_time_to_live", "7"),
+				),
+			},
+			{
+				Config: testAccDataLakeExceptionSubscriptionConfig_timeToLive(acctest.RandomEmailAddress(acctest.RandomDomainName()), 30),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDataLakeExceptionSubscriptionExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "exception_time_to_live", "30"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckDataLakeExceptionSubscriptionDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SecurityLakeClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_securitylake_data_lake_exception_subscription" {
+				continue
+			}
+
+			_, err := tfsecuritylake.FindDataLakeExceptionSubscription(ctx, conn)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Security Lake Data Lake Exception Subscription %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckDataLakeExceptionSubscriptionExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		_, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SecurityLakeClient(ctx)
+
+		_, err := tfsecuritylake.FindDataLakeExceptionSubscription(ctx, conn)
+
+		return err
+	}
+}
+
+func testAccDataLakeExceptionSubscriptionConfig_basic(email string) string {
+	return acctest.ConfigCompose(testAccDataLakeConfig_basic(), fmt.Sprintf(`
+resource "aws_securitylake_data_lake_exception_subscription" "test" {
+  notification_endpoint = %[1]q
+  subscription_protocol = "email"
+
+  depends_on = [aws_securitylake_data_lake.test]
+}
+`, email))
+}
+
+func testAccDataLakeExceptionSubscriptionConfig_timeToLive(email string, ttl int) string {
+	return acctest.ConfigCompose(testAccDataLakeConfig_basic(), fmt.Sprintf(`
+resource "aws_securitylake_data_lake_exception_subscription" "test" {
+  exception_time_to_live = %[2]d
+  notification_endpoint  = %[1]q
+  subscription_protocol  = "email"
+
+  depends_on = [aws_securitylake_data_lake.test]
+}
+`, email, ttl))
+}
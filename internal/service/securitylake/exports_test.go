@@ -5,16 +5,22 @@ package securitylake
 
 // Exports for use in tests only.
 var (
-	ResourceAWSLogSource           = newAWSLogSourceResource
-	ResourceCustomLogSource        = newCustomLogSourceResource
-	ResourceDataLake               = newDataLakeResource
-	ResourceSubscriber             = newSubscriberResource
-	ResourceSubscriberNotification = newSubscriberNotificationResource
+	ResourceAWSLogSource                  = newAWSLogSourceResource
+	ResourceCustomLogSource               = newCustomLogSourceResource
+	ResourceDataLake                      = newDataLakeResource
+	ResourceDataLakeExceptionSubscription = newDataLakeExceptionSubscriptionResource
+	ResourceOrganizationConfiguration     = newOrganizationConfigurationResource
+	ResourceSubscriber                    = newSubscriberResource
+	ResourceSubscriberNotification        = newSubscriberNotificationResource
 
 	FindAWSLogSourceBySourceName             = findAWSLogSourceBySourceName
 	FindCustomLogSourceBySourceName          = findCustomLogSourceBySourceName
 	FindDataLakeByARN                        = findDataLakeByARN
+	FindDataLakeExceptionSubscription        = findDataLakeExceptionSubscription
 	FindDataLakes                            = findDataLakes
+	FindOrganizationConfiguration            = findOrganizationConfiguration
 	FindSubscriberByID                       = findSubscriberByID
 	FindSubscriberNotificationBySubscriberID = findSubscriberNotificationBySubscriberID
+
+	AutoEnableNewAccountConfigurationsDifference = autoEnableNewAccountConfigurationsDifference
 )
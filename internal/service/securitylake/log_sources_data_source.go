@@ -0,0 +1,148 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package securitylake
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/securitylake"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/securitylake/types"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource(name="Log Sources")
+func newLogSourcesDataSource(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &logSourcesDataSource{}, nil
+}
+
+type logSourcesDataSource struct {
+	framework.DataSourceWithConfigure
+}
+
+func (d *logSourcesDataSource) Metadata(_ context.Context, request datasource.MetadataRequest, response *datasource.MetadataResponse) {
+	response.TypeName = "aws_securitylake_log_sources"
+}
+
+func (d *logSourcesDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"accounts": schema.SetAttribute{
+				CustomType:  fwtypes.SetOfStringType,
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			names.AttrID: framework.IDAttribute(),
+			"regions": schema.SetAttribute{
+				CustomType:  fwtypes.SetOfStringType,
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"sources": schema.ListAttribute{
+				CustomType:  fwtypes.NewListNestedObjectTypeOf[logSourceModel](ctx),
+				ElementType: fwtypes.NewObjectTypeOf[logSourceModel](ctx),
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (d *logSourcesDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data logSourcesDataSourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := d.Meta().SecurityLakeClient(ctx)
+
+	input := &securitylake.ListLogSourcesInput{
+		Accounts: fwflex.ExpandFrameworkStringValueSet(ctx, data.Accounts),
+		Regions:  fwflex.ExpandFrameworkStringValueSet(ctx, data.Regions),
+	}
+
+	logSources, err := findLogSources(ctx, conn, input)
+
+	if err != nil {
+		response.Diagnostics.AddError("reading Security Lake Log Sources", err.Error())
+
+		return
+	}
+
+	// We can't use AutoFlEx for the sources because the API structure uses Go interfaces.
+	var sources []logSourceModel
+
+	for _, logSource := range logSources {
+		var awsLogSources []logSourceResourceModel
+		var customLogSources []logSourceResourceModel
+
+		for _, v := range logSource.Sources {
+			switch v := v.(type) {
+			case *awstypes.LogSourceResourceMemberAwsLogSource:
+				awsLogSources = append(awsLogSources, logSourceResourceModel{
+					SourceName:    fwflex.StringValueToFramework(ctx, v.Value.SourceName),
+					SourceVersion: fwflex.StringToFramework(ctx, v.Value.SourceVersion),
+				})
+			case *awstypes.LogSourceResourceMemberCustomLogSource:
+				customLogSources = append(customLogSources, logSourceResourceModel{
+					SourceName:    fwflex.StringToFramework(ctx, v.Value.SourceName),
+					SourceVersion: fwflex.StringToFramework(ctx, v.Value.SourceVersion),
+				})
+			}
+		}
+
+		sources = append(sources, logSourceModel{
+			Account:         fwflex.StringToFramework(ctx, logSource.Account),
+			AWSLogSource:    fwtypes.NewListNestedObjectValueOfValueSliceMust(ctx, awsLogSources),
+			CustomLogSource: fwtypes.NewListNestedObjectValueOfValueSliceMust(ctx, customLogSources),
+			Region:          fwflex.StringToFramework(ctx, logSource.Region),
+		})
+	}
+
+	data.ID = types.StringValue(d.Meta().Region)
+	data.Sources = fwtypes.NewListNestedObjectValueOfValueSliceMust(ctx, sources)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func findLogSources(ctx context.Context, conn *securitylake.Client, input *securitylake.ListLogSourcesInput) ([]awstypes.LogSource, error) {
+	var output []awstypes.LogSource
+
+	pages := securitylake.NewListLogSourcesPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.Sources...)
+	}
+
+	return output, nil
+}
+
+type logSourcesDataSourceModel struct {
+	Accounts fwtypes.SetValueOf[types.String]                `tfsdk:"accounts"`
+	ID       types.String                                    `tfsdk:"id"`
+	Regions  fwtypes.SetValueOf[types.String]                `tfsdk:"regions"`
+	Sources  fwtypes.ListNestedObjectValueOf[logSourceModel] `tfsdk:"sources"`
+}
+
+type logSourceModel struct {
+	Account         types.String                                            `tfsdk:"account"`
+	AWSLogSource    fwtypes.ListNestedObjectValueOf[logSourceResourceModel] `tfsdk:"aws_log_source"`
+	CustomLogSource fwtypes.ListNestedObjectValueOf[logSourceResourceModel] `tfsdk:"custom_log_source"`
+	Region          types.String                                            `tfsdk:"region"`
+}
+
+type logSourceResourceModel struct {
+	SourceName    types.String `tfsdk:"source_name"`
+	SourceVersion types.String `tfsdk:"source_version"`
+}
@@ -0,0 +1,53 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package securitylake_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func testAccLogSourcesDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_securitylake_log_sources.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.SecurityLake)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.SecurityLakeServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAWSLogSourceDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLogSourcesDataSourceConfig_basic(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "sources.#", acctest.Ct1),
+					acctest.CheckResourceAttrAccountID(dataSourceName, "sources.0.account"),
+					resource.TestCheckResourceAttr(dataSourceName, "sources.0.region", acctest.Region()),
+					resource.TestCheckResourceAttr(dataSourceName, "sources.0.aws_log_source.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(dataSourceName, "sources.0.aws_log_source.0.source_name", "ROUTE53"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "sources.0.aws_log_source.0.source_version"),
+					resource.TestCheckResourceAttr(dataSourceName, "sources.0.custom_log_source.#", acctest.Ct0),
+				),
+			},
+		},
+	})
+}
+
+func testAccLogSourcesDataSourceConfig_basic() string {
+	return acctest.ConfigCompose(testAccAWSLogSourceConfig_basic(), `
+data "aws_securitylake_log_sources" "test" {
+  accounts = [data.aws_caller_identity.current.account_id]
+  regions  = [data.aws_region.current.name]
+
+  depends_on = [aws_securitylake_aws_log_source.test]
+}
+`)
+}
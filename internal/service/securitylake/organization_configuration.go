@@ -0,0 +1,358 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package securitylake
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/securitylake"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/securitylake/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Organization Configuration")
+func newOrganizationConfigurationResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &organizationConfigurationResource{}
+
+	return r, nil
+}
+
+type organizationConfigurationResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+}
+
+func (r *organizationConfigurationResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_securitylake_organization_configuration"
+}
+
+func (r *organizationConfigurationResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrID: framework.IDAttribute(),
+		},
+		Blocks: map[string]schema.Block{
+			"auto_enable_new_account": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[autoEnableNewAccountConfigurationModel](ctx),
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						names.AttrRegion: schema.StringAttribute{
+							Required: true,
+						},
+					},
+					Blocks: map[string]schema.Block{
+						"sources": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[autoEnableAWSLogSourceModel](ctx),
+							Validators: []validator.List{
+								listvalidator.IsRequired(),
+								listvalidator.SizeAtLeast(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"source_name": schema.StringAttribute{
+										CustomType: fwtypes.StringEnumType[awstypes.AwsLogSourceName](),
+										Required:   true,
+									},
+									"source_version": schema.StringAttribute{
+										Optional: true,
+										Computed: true,
+										PlanModifiers: []planmodifier.String{
+											stringplanmodifier.UseStateForUnknown(),
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *organizationConfigurationResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data organizationConfigurationResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().SecurityLakeClient(ctx)
+
+	input := &securitylake.CreateDataLakeOrganizationConfigurationInput{}
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := retryDataLakeConflictWithMutex(ctx, func() (*securitylake.CreateDataLakeOrganizationConfigurationOutput, error) {
+		return conn.CreateDataLakeOrganizationConfiguration(ctx, input)
+	})
+
+	if err != nil {
+		response.Diagnostics.AddError("creating Security Lake Organization Configuration", err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.ID = types.StringValue(r.Meta().Region)
+
+	output, err := findOrganizationConfiguration(ctx, conn)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Security Lake Organization Configuration (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *organizationConfigurationResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data organizationConfigurationResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().SecurityLakeClient(ctx)
+
+	output, err := findOrganizationConfiguration(ctx, conn)
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Security Lake Organization Configuration (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *organizationConfigurationResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new organizationConfigurationResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().SecurityLakeClient(ctx)
+
+	oldInput := &securitylake.DeleteDataLakeOrganizationConfigurationInput{}
+	response.Diagnostics.Append(fwflex.Expand(ctx, old, oldInput)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	newInput := &securitylake.CreateDataLakeOrganizationConfigurationInput{}
+	response.Diagnostics.Append(fwflex.Expand(ctx, new, newInput)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// There is no update API. Add any new configuration before removing what's no longer configured
+	// so that accounts joining the organization while the update is in progress are still enabled.
+	if add := autoEnableNewAccountConfigurationsDifference(newInput.AutoEnableNewAccount, oldInput.AutoEnableNewAccount, true); len(add) > 0 {
+		input := &securitylake.CreateDataLakeOrganizationConfigurationInput{
+			AutoEnableNewAccount: add,
+		}
+
+		_, err := retryDataLakeConflictWithMutex(ctx, func() (*securitylake.CreateDataLakeOrganizationConfigurationOutput, error) {
+			return conn.CreateDataLakeOrganizationConfiguration(ctx, input)
+		})
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating Security Lake Organization Configuration (%s)", new.ID.ValueString()), err.Error())
+
+			return
+		}
+	}
+
+	// Source version changes are handled by the create above, so only region and source name are compared here.
+	if del := autoEnableNewAccountConfigurationsDifference(oldInput.AutoEnableNewAccount, newInput.AutoEnableNewAccount, false); len(del) > 0 {
+		input := &securitylake.DeleteDataLakeOrganizationConfigurationInput{
+			AutoEnableNewAccount: del,
+		}
+
+		_, err := retryDataLakeConflictWithMutex(ctx, func() (*securitylake.DeleteDataLakeOrganizationConfigurationOutput, error) {
+			return conn.DeleteDataLakeOrganizationConfiguration(ctx, input)
+		})
+
+		if err != nil && !errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			response.Diagnostics.AddError(fmt.Sprintf("updating Security Lake Organization Configuration (%s)", new.ID.ValueString()), err.Error())
+
+			return
+		}
+	}
+
+	output, err := findOrganizationConfiguration(ctx, conn)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Security Lake Organization Configuration (%s)", new.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *organizationConfigurationResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data organizationConfigurationResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().SecurityLakeClient(ctx)
+
+	input := &securitylake.DeleteDataLakeOrganizationConfigurationInput{}
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := retryDataLakeConflictWithMutex(ctx, func() (*securitylake.DeleteDataLakeOrganizationConfigurationOutput, error) {
+		return conn.DeleteDataLakeOrganizationConfiguration(ctx, input)
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Security Lake Organization Configuration (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func findOrganizationConfiguration(ctx context.Context, conn *securitylake.Client) (*securitylake.GetDataLakeOrganizationConfigurationOutput, error) {
+	input := &securitylake.GetDataLakeOrganizationConfigurationInput{}
+
+	output, err := conn.GetDataLakeOrganizationConfiguration(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.AutoEnableNewAccount) == 0 {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+// autoEnableNewAccountConfigurationsDifference returns the sources in a that aren't in b, grouped by Region.
+// Source versions are compared only if compareVersions is set and both versions are known.
+func autoEnableNewAccountConfigurationsDifference(a, b []awstypes.DataLakeAutoEnableNewAccountConfiguration, compareVersions bool) []awstypes.DataLakeAutoEnableNewAccountConfiguration {
+	var apiObjects []awstypes.DataLakeAutoEnableNewAccountConfiguration
+
+	for _, x := range a {
+		var sources []awstypes.AwsLogSourceResource
+
+		for _, source := range x.Sources {
+			found := false
+
+			for _, y := range b {
+				if aws.ToString(y.Region) != aws.ToString(x.Region) {
+					continue
+				}
+
+				for _, v := range y.Sources {
+					if v.SourceName != source.SourceName {
+						continue
+					}
+
+					if compareVersions && source.SourceVersion != nil && v.SourceVersion != nil && aws.ToString(v.SourceVersion) != aws.ToString(source.SourceVersion) {
+						continue
+					}
+
+					found = true
+					break
+				}
+			}
+
+			if !found {
+				sources = append(sources, source)
+			}
+		}
+
+		if len(sources) > 0 {
+			apiObjects = append(apiObjects, awstypes.DataLakeAutoEnableNewAccountConfiguration{
+				Region:  x.Region,
+				Sources: sources,
+			})
+		}
+	}
+
+	return apiObjects
+}
+
+type organizationConfigurationResourceModel struct {
+	AutoEnableNewAccount fwtypes.ListNestedObjectValueOf[autoEnableNewAccountConfigurationModel] `tfsdk:"auto_enable_new_account"`
+	ID                   types.String                                                            `tfsdk:"id"`
+}
+
+type autoEnableNewAccountConfigurationModel struct {
+	Region  types.String                                                 `tfsdk:"region"`
+	Sources fwtypes.ListNestedObjectValueOf[autoEnableAWSLogSourceModel] `tfsdk:"sources"`
+}
+
+type autoEnableAWSLogSourceModel struct {
+	SourceName    fwtypes.StringEnum[awstypes.AwsLogSourceName] `tfsdk:"source_name"`
+	SourceVersion types.String                                  `tfsdk:"source_version"`
+}
@@ -0,0 +1,295 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package securitylake_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/securitylake/types"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfsecuritylake "github.com/hashicorp/terraform-provider-aws/internal/service/securitylake"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAutoEnableNewAccountConfigurationsDifference(t *testing.T) {
+	t.Parallel()
+
+	configuration := func(region string, sources ...awstypes.AwsLogSourceResource) awstypes.DataLakeAutoEnableNewAccountConfiguration {
+		return awstypes.DataLakeAutoEnableNewAccountConfiguration{
+			Region:  aws.String(region),
+			Sources: sources,
+		}
+	}
+	source := func(name awstypes.AwsLogSourceName, version string) awstypes.AwsLogSourceResource {
+		v := awstypes.AwsLogSourceResource{
+			SourceName: name,
+		}
+		if version != "" {
+			v.SourceVersion = aws.String(version)
+		}
+		return v
+	}
+
+	testCases := map[string]struct {
+		a, b            []awstypes.DataLakeAutoEnableNewAccountConfiguration
+		compareVersions bool
+		expected        []awstypes.DataLakeAutoEnableNewAccountConfiguration
+	}{
+		"both empty": {},
+		"equal": {
+			a: []awstypes.DataLakeAutoEnableNewAccountConfiguration{
+				configuration("us-east-1", source(awstypes.AwsLogSourceNameRoute53, "2.0")),
+			},
+			b: []awstypes.DataLakeAutoEnableNewAccountConfiguration{
+				configuration("us-east-1", source(awstypes.AwsLogSourceNameRoute53, "2.0")),
+			},
+			compareVersions: true,
+		},
+		"source added": {
+			a: []awstypes.DataLakeAutoEnableNewAccountConfiguration{
+				configuration("us-east-1", source(awstypes.AwsLogSourceNameRoute53, ""), source(awstypes.AwsLogSourceNameVpcFlow, "")),
+			},
+			b: []awstypes.DataLakeAutoEnableNewAccountConfiguration{
+				configuration("us-east-1", source(awstypes.AwsLogSourceNameRoute53, "2.0")),
+			},
+			compareVersions: true,
+			expected: []awstypes.DataLakeAutoEnableNewAccountConfiguration{
+				configuration("us-east-1", source(awstypes.AwsLogSourceNameVpcFlow, "")),
+			},
+		},
+		"region removed": {
+			a: []awstypes.DataLakeAutoEnableNewAccountConfiguration{
+				configuration("us-east-1", source(awstypes.AwsLogSourceNameRoute53, "2.0")),
+				configuration("us-west-2", source(awstypes.AwsLogSourceNameRoute53, "2.0")),
+			},
+			b: []awstypes.DataLakeAutoEnableNewAccountConfiguration{
+				configuration("us-east-1", source(awstypes.AwsLogSourceNameRoute53, "")),
+			},
+			expected: []awstypes.DataLakeAutoEnableNewAccountConfiguration{
+				configuration("us-west-2", source(awstypes.AwsLogSourceNameRoute53, "2.0")),
+			},
+		},
+		"version changed": {
+			a: []awstypes.DataLakeAutoEnableNewAccountConfiguration{
+				configuration("us-east-1", source(awstypes.AwsLogSourceNameRoute53, "2.0")),
+			},
+			b: []awstypes.DataLakeAutoEnableNewAccountConfiguration{
+				configuration("us-east-1", source(awstypes.AwsLogSourceNameRoute53, "1.0")),
+			},
+			compareVersions: true,
+			expected: []awstypes.DataLakeAutoEnableNewAccountConfiguration{
+				configuration("us-east-1", source(awstypes.AwsLogSourceNameRoute53, "2.0")),
+			},
+		},
+		"version changed ignored": {
+			a: []awstypes.DataLakeAutoEnableNewAccountConfiguration{
+				configuration("us-east-1", source(awstypes.AwsLogSourceNameRoute53, "1.0")),
+			},
+			b: []awstypes.DataLakeAutoEnableNewAccountConfiguration{
+				configuration("us-east-1", source(awstypes.AwsLogSourceNameRoute53, "2.0")),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := tfsecuritylake.AutoEnableNewAccountConfigurationsDifference(testCase.a, testCase.b, testCase.compareVersions)
+
+			if diff := cmp.Diff(got, testCase.expected, cmpopts.IgnoreUnexported(awstypes.DataLakeAutoEnableNewAccountConfiguration{}, awstypes.AwsLogSourceResource{})); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func testAccOrganizationConfiguration_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_securitylake_organization_configuration.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.SecurityLake)
+			acctest.PreCheckOrganizationsEnabled(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.SecurityLakeServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckOrganizationConfigurationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOrganizationConfigurationConfig_basic(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckOrganizationConfigurationExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "auto_enable_new_account.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "auto_enable_new_account.0.region", acctest.Region()),
+					resource.TestCheckResourceAttr(resourceName, "auto_enable_new_account.0.sources.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "auto_enable_new_account.0.sources.0.source_name", "ROUTE53"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrID, acctest.Region()),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccOrganizationConfiguration_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_securitylake_organization_configuration.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.SecurityLake)
+			acctest.PreCheckOrganizationsEnabled(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.SecurityLakeServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckOrganizationConfigurationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOrganizationConfigurationConfig_basic(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckOrganizationConfigurationExists(ctx, resourceName),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfsecuritylake.ResourceOrganizationConfiguration, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccOrganizationConfiguration_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_securitylake_organization_configuration.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.SecurityLake)
+			acctest.PreCheckOrganizationsEnabled(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.SecurityLakeServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckOrganizationConfigurationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOrganizationConfigurationConfig_basic(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckOrganizationConfigurationExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "auto_enable_new_account.0.sources.#", acctest.Ct1),
+				),
+			},
+			{
+				Config: testAccOrganizationConfigurationConfig_multipleSources(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckOrganizationConfigurationExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "auto_enable_new_account.0.sources.#", acctest.Ct2),
+					resource.TestCheckResourceAttr(resourceName, "auto_enable_new_account.0.sources.0.source_name", "ROUTE53"),
+					resource.TestCheckResourceAttr(resourceName, "auto_enable_new_account.0.sources.1.source_name", "VPC_FLOW"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckOrganizationConfigurationDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SecurityLakeClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_securitylake_organization_configuration" {
+				continue
+			}
+
+			_, err := tfsecuritylake.FindOrganizationConfiguration(ctx, conn)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Security Lake Organization Configuration %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckOrganizationConfigurationExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		_, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SecurityLakeClient(ctx)
+
+		_, err := tfsecuritylake.FindOrganizationConfiguration(ctx, conn)
+
+		return err
+	}
+}
+
+func testAccOrganizationConfigurationConfig_basic() string {
+	return acctest.ConfigCompose(testAccDataLakeConfig_basic(), `
+data "aws_region" "current" {}
+
+resource "aws_securitylake_organization_configuration" "test" {
+  auto_enable_new_account {
+    region = data.aws_region.current.name
+
+    sources {
+      source_name = "ROUTE53"
+    }
+  }
+
+  depends_on = [aws_securitylake_data_lake.test]
+}
+`)
+}
+
+func testAccOrganizationConfigurationConfig_multipleSources() string {
+	return acctest.ConfigCompose(testAccDataLakeConfig_basic(), `
+data "aws_region" "current" {}
+
+resource "aws_securitylake_organization_configuration" "test" {
+  auto_enable_new_account {
+    region = data.aws_region.current.name
+
+    sources {
+      source_name = "ROUTE53"
+    }
+
+    sources {
+      source_name = "VPC_FLOW"
+    }
+  }
+
+  depends_on = [aws_securitylake_data_lake.test]
+}
+`)
+}
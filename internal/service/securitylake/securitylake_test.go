@@ -37,6 +37,11 @@ func TestAccSecurityLake_serial(t *testing.T) {
 			"multiple":           testAccCustomLogSource_multiple,
 			"sourceVersion":      testAccCustomLogSource_sourceVersion,
 		},
+		"DataLakeExceptionSubscription": {
+			acctest.CtBasic:      testAccDataLakeExceptionSubscription_basic,
+			acctest.CtDisappears: testAccDataLakeExceptionSubscription_disappears,
+			"update":             testAccDataLakeExceptionSubscription_update,
+		},
 		"DataLake": {
 			acctest.CtBasic:      testAccDataLake_basic,
 			acctest.CtDisappears: testAccDataLake_disappears,
@@ -45,6 +50,14 @@ func TestAccSecurityLake_serial(t *testing.T) {
 			"lifecycleUpdate":    testAccDataLake_lifeCycleUpdate,
 			"replication":        testAccDataLake_replication,
 		},
+		"LogSourcesDataSource": {
+			acctest.CtBasic: testAccLogSourcesDataSource_basic,
+		},
+		"OrganizationConfiguration": {
+			acctest.CtBasic:      testAccOrganizationConfiguration_basic,
+			acctest.CtDisappears: testAccOrganizationConfiguration_disappears,
+			"update":             testAccOrganizationConfiguration_update,
+		},
 		"Subscriber": {
 			"accessType":         testAccSubscriber_accessType,
 			acctest.CtBasic:      testAccSubscriber_basic,
@@ -15,7 +15,12 @@ import (
 type servicePackage struct{}
 
 func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*types.ServicePackageFrameworkDataSource {
-	return []*types.ServicePackageFrameworkDataSource{}
+	return []*types.ServicePackageFrameworkDataSource{
+		{
+			Factory: newLogSourcesDataSource,
+			Name:    "Log Sources",
+		},
+	}
 }
 
 func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.ServicePackageFrameworkResource {
@@ -28,6 +33,10 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 			Factory: newCustomLogSourceResource,
 			Name:    "Custom Log Source",
 		},
+		{
+			Factory: newDataLakeExceptionSubscriptionResource,
+			Name:    "Data Lake Exception Subscription",
+		},
 		{
 			Factory: newDataLakeResource,
 			Name:    "Data Lake",
@@ -35,6 +44,10 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 				IdentifierAttribute: names.AttrARN,
 			},
 		},
+		{
+			Factory: newOrganizationConfigurationResource,
+			Name:    "Organization Configuration",
+		},
 		{
 			Factory: newSubscriberNotificationResource,
 			Name:    "Subscriber Notification",
@@ -0,0 +1,430 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ssoadmin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ssoadmin"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ssoadmin/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+const (
+	// Number of account assignment operations (or account reads) in flight at any one time.
+	accountAssignmentsMaxConcurrency = 10
+)
+
+// @SDKResource("aws_ssoadmin_account_assignments")
+func ResourceAccountAssignments() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceAccountAssignmentsCreate,
+		ReadWithoutTimeout:   resourceAccountAssignmentsRead,
+		UpdateWithoutTimeout: resourceAccountAssignmentsUpdate,
+		DeleteWithoutTimeout: resourceAccountAssignmentsDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"assignment": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"principal_id": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.All(
+								validation.StringLenBetween(1, 47),
+								validation.StringMatch(regexache.MustCompile(`^([0-9a-f]{10}-|)[0-9A-Fa-f]{8}-[0-9A-Fa-f]{4}-[0-9A-Fa-f]{4}-[0-9A-Fa-f]{4}-[0-9A-Fa-f]{12}$`), "must match ([0-9a-f]{10}-|)[0-9A-Fa-f]{8}-[0-9A-Fa-f]{4}-[0-9A-Fa-f]{4}-[0-9A-Fa-f]{4}-[0-9A-Fa-f]{12}"),
+							),
+						},
+						"principal_type": {
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateDiagFunc: enum.Validate[awstypes.PrincipalType](),
+						},
+						"target_id": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: verify.ValidAccountID,
+						},
+					},
+				},
+			},
+			"instance_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"permission_set_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+		},
+	}
+}
+
+func resourceAccountAssignmentsCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SSOAdminClient(ctx)
+
+	instanceARN := d.Get("instance_arn").(string)
+	permissionSetARN := d.Get("permission_set_arn").(string)
+
+	// The resource is authoritative, so any existing assignments for the permission set not in configuration are removed.
+	current, err := findAccountAssignmentsByPermissionSet(ctx, conn, permissionSetARN, instanceARN)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading SSO Account Assignments for Permission Set (%s): %s", permissionSetARN, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s,%s", permissionSetARN, instanceARN))
+
+	if err := reconcileAccountAssignments(ctx, conn, permissionSetARN, instanceARN, current, expandAccountAssignments(d.Get("assignment").(*schema.Set).List()), d.Timeout(schema.TimeoutCreate)); err != nil {
+		diags = sdkdiag.AppendErrorf(diags, "creating SSO Account Assignments for Permission Set (%s): %s", permissionSetARN, err)
+	}
+
+	return append(diags, resourceAccountAssignmentsRead(ctx, d, meta)...)
+}
+
+func resourceAccountAssignmentsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SSOAdminClient(ctx)
+
+	permissionSetARN, instanceARN, err := ParseResourceID(d.Id())
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	_, err = FindPermissionSet(ctx, conn, permissionSetARN, instanceARN)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] SSO Permission Set (%s) not found, removing SSO Account Assignments from state", permissionSetARN)
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading SSO Permission Set (%s): %s", permissionSetARN, err)
+	}
+
+	assignments, err := findAccountAssignmentsByPermissionSet(ctx, conn, permissionSetARN, instanceARN)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading SSO Account Assignments for Permission Set (%s): %s", permissionSetARN, err)
+	}
+
+	if err := d.Set("assignment", flattenAccountAssignments(assignments)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting assignment: %s", err)
+	}
+	d.Set("instance_arn", instanceARN)
+	d.Set("permission_set_arn", permissionSetARN)
+
+	return diags
+}
+
+func resourceAccountAssignmentsUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SSOAdminClient(ctx)
+
+	permissionSetARN, instanceARN, err := ParseResourceID(d.Id())
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	if d.HasChange("assignment") {
+		o, n := d.GetChange("assignment")
+
+		if err := reconcileAccountAssignments(ctx, conn, permissionSetARN, instanceARN, expandAccountAssignments(o.(*schema.Set).List()), expandAccountAssignments(n.(*schema.Set).List()), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			diags = sdkdiag.AppendErrorf(diags, "updating SSO Account Assignments for Permission Set (%s): %s", permissionSetARN, err)
+		}
+	}
+
+	return append(diags, resourceAccountAssignmentsRead(ctx, d, meta)...)
+}
+
+func resourceAccountAssignmentsDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SSOAdminClient(ctx)
+
+	permissionSetARN, instanceARN, err := ParseResourceID(d.Id())
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	log.Printf("[DEBUG] Deleting SSO Account Assignments for Permission Set: %s", permissionSetARN)
+	if err := reconcileAccountAssignments(ctx, conn, permissionSetARN, instanceARN, expandAccountAssignments(d.Get("assignment").(*schema.Set).List()), nil, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting SSO Account Assignments for Permission Set (%s): %s", permissionSetARN, err)
+	}
+
+	return diags
+}
+
+// accountAssignment uniquely identifies an account assignment within a permission set.
+type accountAssignment struct {
+	principalID   string
+	principalType string
+	targetID      string
+}
+
+// reconcileAccountAssignments concurrently creates the assignments in want that are not in have
+// and deletes the assignments in have that are not in want, waiting for each request to complete.
+func reconcileAccountAssignments(ctx context.Context, conn *ssoadmin.Client, permissionSetARN, instanceARN string, have, want []accountAssignment, timeout time.Duration) error {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	var operations []func(context.Context) error
+
+	for _, v := range tfslices.Filter(have, func(v accountAssignment) bool { return !slices.Contains(want, v) }) {
+		operations = append(operations, func(ctx context.Context) error {
+			return deleteAccountAssignment(ctx, conn, permissionSetARN, instanceARN, v, timeout)
+		})
+	}
+
+	for _, v := range tfslices.Filter(want, func(v accountAssignment) bool { return !slices.Contains(have, v) }) {
+		operations = append(operations, func(ctx context.Context) error {
+			return createAccountAssignment(ctx, conn, permissionSetARN, instanceARN, v, timeout)
+		})
+	}
+
+	return runConcurrently(ctx, operations)
+}
+
+func createAccountAssignment(ctx context.Context, conn *ssoadmin.Client, permissionSetARN, instanceARN string, assignment accountAssignment, timeout time.Duration) error {
+	input := &ssoadmin.CreateAccountAssignmentInput{
+		InstanceArn:      aws.String(instanceARN),
+		PermissionSetArn: aws.String(permissionSetARN),
+		PrincipalId:      aws.String(assignment.principalID),
+		PrincipalType:    awstypes.PrincipalType(assignment.principalType),
+		TargetId:         aws.String(assignment.targetID),
+		TargetType:       awstypes.TargetTypeAwsAccount,
+	}
+
+	// Concurrent requests against the same permission set can conflict while it is being provisioned.
+	outputRaw, err := tfresource.RetryWhenIsA[*awstypes.ConflictException](ctx, timeout, func() (interface{}, error) {
+		return conn.CreateAccountAssignment(ctx, input)
+	})
+
+	if err != nil {
+		return fmt.Errorf("creating assignment for %s (%s) in account (%s): %w", assignment.principalType, assignment.principalID, assignment.targetID, err)
+	}
+
+	requestID := aws.ToString(outputRaw.(*ssoadmin.CreateAccountAssignmentOutput).AccountAssignmentCreationStatus.RequestId)
+
+	if _, err := waitAccountAssignmentCreated(ctx, conn, instanceARN, requestID, timeout); err != nil {
+		return fmt.Errorf("waiting for assignment for %s (%s) in account (%s) create: %w", assignment.principalType, assignment.principalID, assignment.targetID, err)
+	}
+
+	return nil
+}
+
+func deleteAccountAssignment(ctx context.Context, conn *ssoadmin.Client, permissionSetARN, instanceARN string, assignment accountAssignment, timeout time.Duration) error {
+	input := &ssoadmin.DeleteAccountAssignmentInput{
+		InstanceArn:      aws.String(instanceARN),
+		PermissionSetArn: aws.String(permissionSetARN),
+		PrincipalId:      aws.String(assignment.principalID),
+		PrincipalType:    awstypes.PrincipalType(assignment.principalType),
+		TargetId:         aws.String(assignment.targetID),
+		TargetType:       awstypes.TargetTypeAwsAccount,
+	}
+
+	outputRaw, err := tfresource.RetryWhenIsA[*awstypes.ConflictException](ctx, timeout, func() (interface{}, error) {
+		return conn.DeleteAccountAssignment(ctx, input)
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("deleting assignment for %s (%s) in account (%s): %w", assignment.principalType, assignment.principalID, assignment.targetID, err)
+	}
+
+	requestID := aws.ToString(outputRaw.(*ssoadmin.DeleteAccountAssignmentOutput).AccountAssignmentDeletionStatus.RequestId)
+
+	if _, err := waitAccountAssignmentDeleted(ctx, conn, instanceARN, requestID, timeout); err != nil {
+		return fmt.Errorf("waiting for assignment for %s (%s) in account (%s) delete: %w", assignment.principalType, assignment.principalID, assignment.targetID, err)
+	}
+
+	return nil
+}
+
+// runConcurrently runs the specified functions with at most accountAssignmentsMaxConcurrency in flight,
+// returning all of their errors joined together.
+func runConcurrently(ctx context.Context, fs []func(context.Context) error) error {
+	var (
+		mu      sync.Mutex
+		allErrs []error
+		wg      sync.WaitGroup
+	)
+	sem := make(chan struct{}, accountAssignmentsMaxConcurrency)
+
+	for _, f := range fs {
+		wg.Add(1)
+		sem <- struct{}{}
+
+		go func() {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+
+			if err := f(ctx); err != nil {
+				mu.Lock()
+				allErrs = append(allErrs, err)
+				mu.Unlock()
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	return errors.Join(allErrs...)
+}
+
+// findAccountAssignmentsByPermissionSet returns all account assignments for the specified permission set
+// across every account to which it is provisioned.
+func findAccountAssignmentsByPermissionSet(ctx context.Context, conn *ssoadmin.Client, permissionSetARN, instanceARN string) ([]accountAssignment, error) {
+	accountIDs, err := findAccountsForProvisionedPermissionSet(ctx, conn, permissionSetARN, instanceARN)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		mu     sync.Mutex
+		output []accountAssignment
+	)
+	var fs []func(context.Context) error
+
+	for _, accountID := range accountIDs {
+		fs = append(fs, func(ctx context.Context) error {
+			input := &ssoadmin.ListAccountAssignmentsInput{
+				AccountId:        aws.String(accountID),
+				InstanceArn:      aws.String(instanceARN),
+				PermissionSetArn: aws.String(permissionSetARN),
+			}
+
+			assignments, err := findAccountAssignments(ctx, conn, input, tfslices.PredicateTrue[awstypes.AccountAssignment]())
+
+			if tfresource.NotFound(err) {
+				return nil
+			}
+
+			if err != nil {
+				return fmt.Errorf("listing assignments in account (%s): %w", accountID, err)
+			}
+
+			mu.Lock()
+			defer mu.Unlock()
+
+			for _, v := range assignments {
+				output = append(output, accountAssignment{
+					principalID:   aws.ToString(v.PrincipalId),
+					principalType: string(v.PrincipalType),
+					targetID:      aws.ToString(v.AccountId),
+				})
+			}
+
+			return nil
+		})
+	}
+
+	if err := runConcurrently(ctx, fs); err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func findAccountsForProvisionedPermissionSet(ctx context.Context, conn *ssoadmin.Client, permissionSetARN, instanceARN string) ([]string, error) {
+	input := &ssoadmin.ListAccountsForProvisionedPermissionSetInput{
+		InstanceArn:      aws.String(instanceARN),
+		PermissionSetArn: aws.String(permissionSetARN),
+	}
+	var output []string
+
+	paginator := ssoadmin.NewListAccountsForProvisionedPermissionSetPaginator(conn, input)
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.AccountIds...)
+	}
+
+	return output, nil
+}
+
+func expandAccountAssignments(tfList []interface{}) []accountAssignment {
+	var apiObjects []accountAssignment
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, accountAssignment{
+			principalID:   tfMap["principal_id"].(string),
+			principalType: tfMap["principal_type"].(string),
+			targetID:      tfMap["target_id"].(string),
+		})
+	}
+
+	return apiObjects
+}
+
+func flattenAccountAssignments(apiObjects []accountAssignment) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, map[string]interface{}{
+			"principal_id":   apiObject.principalID,
+			"principal_type": apiObject.principalType,
+			"target_id":      apiObject.targetID,
+		})
+	}
+
+	return tfList
+}
@@ -0,0 +1,194 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ssoadmin_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfssoadmin "github.com/hashicorp/terraform-provider-aws/internal/service/ssoadmin"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccSSOAdminAccountAssignments_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_ssoadmin_account_assignments.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	groupName := os.Getenv("AWS_IDENTITY_STORE_GROUP_NAME")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckSSOAdminInstances(ctx, t)
+			testAccPreCheckIdentityStoreGroupName(t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.SSOAdminServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAccountAssignmentsDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAccountAssignmentsConfig_basic(groupName, rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAccountAssignmentsCount(ctx, resourceName, 1),
+					resource.TestCheckResourceAttrPair(resourceName, "instance_arn", "aws_ssoadmin_permission_set.test", "instance_arn"),
+					resource.TestCheckResourceAttrPair(resourceName, "permission_set_arn", "aws_ssoadmin_permission_set.test", names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "assignment.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "assignment.*", map[string]string{
+						"principal_type": "GROUP",
+					}),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "assignment.*.principal_id", "data.aws_identitystore_group.test", "group_id"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "assignment.*.target_id", "data.aws_caller_identity.current", names.AttrAccountID),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAccountAssignmentsConfig_empty(groupName, rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAccountAssignmentsCount(ctx, resourceName, 0),
+					resource.TestCheckResourceAttr(resourceName, "assignment.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSSOAdminAccountAssignments_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_ssoadmin_account_assignments.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	groupName := os.Getenv("AWS_IDENTITY_STORE_GROUP_NAME")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckSSOAdminInstances(ctx, t)
+			testAccPreCheckIdentityStoreGroupName(t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.SSOAdminServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAccountAssignmentsDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAccountAssignmentsConfig_basic(groupName, rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAccountAssignmentsCount(ctx, resourceName, 1),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfssoadmin.ResourceAccountAssignments(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAccountAssignmentsDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SSOAdminClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_ssoadmin_account_assignments" {
+				continue
+			}
+
+			permissionSetARN, instanceARN, err := tfssoadmin.ParseResourceID(rs.Primary.ID)
+			if err != nil {
+				return err
+			}
+
+			output, err := tfssoadmin.FindAccountAssignmentsByPermissionSet(ctx, conn, permissionSetARN, instanceARN)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			if len(output) > 0 {
+				return fmt.Errorf("SSO Account Assignments for Permission Set (%s) still exist", permissionSetARN)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckAccountAssignmentsCount(ctx context.Context, n string, want int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SSOAdminClient(ctx)
+
+		permissionSetARN, instanceARN, err := tfssoadmin.ParseResourceID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		output, err := tfssoadmin.FindAccountAssignmentsByPermissionSet(ctx, conn, permissionSetARN, instanceARN)
+
+		if err != nil {
+			return err
+		}
+
+		if got := len(output); got != want {
+			return fmt.Errorf("SSO Account Assignments for Permission Set (%s): got %d, want %d", permissionSetARN, got, want)
+		}
+
+		return nil
+	}
+}
+
+func testAccAccountAssignmentsConfig_base(groupName, rName string) string {
+	return acctest.ConfigCompose(testAccAccountAssignmentConfig_base(rName), fmt.Sprintf(`
+data "aws_identitystore_group" "test" {
+  identity_store_id = tolist(data.aws_ssoadmin_instances.test.identity_store_ids)[0]
+
+  alternate_identifier {
+    unique_attribute {
+      attribute_path  = "DisplayName"
+      attribute_value = %[1]q
+    }
+  }
+}
+`, groupName))
+}
+
+func testAccAccountAssignmentsConfig_basic(groupName, rName string) string {
+	return acctest.ConfigCompose(testAccAccountAssignmentsConfig_base(groupName, rName), `
+resource "aws_ssoadmin_account_assignments" "test" {
+  instance_arn       = aws_ssoadmin_permission_set.test.instance_arn
+  permission_set_arn = aws_ssoadmin_permission_set.test.arn
+
+  assignment {
+    principal_type = "GROUP"
+    principal_id   = data.aws_identitystore_group.test.group_id
+    target_id      = data.aws_caller_identity.current.account_id
+  }
+}
+`)
+}
+
+func testAccAccountAssignmentsConfig_empty(groupName, rName string) string {
+	return acctest.ConfigCompose(testAccAccountAssignmentsConfig_base(groupName, rName), `
+resource "aws_ssoadmin_account_assignments" "test" {
+  instance_arn       = aws_ssoadmin_permission_set.test.instance_arn
+  permission_set_arn = aws_ssoadmin_permission_set.test.arn
+}
+`)
+}
@@ -15,5 +15,6 @@ var (
 	FindApplicationAssignmentByID              = findApplicationAssignmentByID
 	FindApplicationAssignmentConfigurationByID = findApplicationAssignmentConfigurationByID
 	FindApplicationAccessScopeByID             = findApplicationAccessScopeByID
+	FindAccountAssignmentsByPermissionSet      = findAccountAssignmentsByPermissionSet
 	FindTrustedTokenIssuerByARN                = findTrustedTokenIssuerByARN
 )
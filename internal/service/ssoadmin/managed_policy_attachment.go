@@ -29,6 +29,7 @@ func ResourceManagedPolicyAttachment() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceManagedPolicyAttachmentCreate,
 		ReadWithoutTimeout:   resourceManagedPolicyAttachmentRead,
+		UpdateWithoutTimeout: schema.NoopContext, // Allow skip_provisioning update.
 		DeleteWithoutTimeout: resourceManagedPolicyAttachmentDelete,
 
 		Importer: &schema.ResourceImporter{
@@ -63,6 +64,11 @@ func ResourceManagedPolicyAttachment() *schema.Resource {
 				ForceNew:     true,
 				ValidateFunc: verify.ValidARN,
 			},
+			"skip_provisioning": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 		},
 	}
 }
@@ -99,8 +105,10 @@ func resourceManagedPolicyAttachmentCreate(ctx context.Context, d *schema.Resour
 	d.SetId(fmt.Sprintf("%s,%s,%s", managedPolicyARN, permissionSetARN, instanceARN))
 
 	// Provision ALL accounts after attaching the managed policy.
-	if err := provisionPermissionSet(ctx, conn, permissionSetARN, instanceARN, d.Timeout(schema.TimeoutCreate)); err != nil {
-		return sdkdiag.AppendFromErr(diags, err)
+	if !d.Get("skip_provisioning").(bool) {
+		if err := provisionPermissionSet(ctx, conn, permissionSetARN, instanceARN, d.Timeout(schema.TimeoutCreate)); err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
 	}
 
 	return append(diags, resourceManagedPolicyAttachmentRead(ctx, d, meta)...)
@@ -131,6 +139,9 @@ func resourceManagedPolicyAttachmentRead(ctx context.Context, d *schema.Resource
 	d.Set("managed_policy_arn", policy.Arn)
 	d.Set("managed_policy_name", policy.Name)
 	d.Set("permission_set_arn", permissionSetARN)
+	if _, ok := d.GetOk("skip_provisioning"); !ok {
+		d.Set("skip_provisioning", false)
+	}
 
 	return diags
 }
@@ -161,8 +172,10 @@ func resourceManagedPolicyAttachmentDelete(ctx context.Context, d *schema.Resour
 	}
 
 	// Provision ALL accounts after detaching the managed policy.
-	if err := provisionPermissionSet(ctx, conn, permissionSetARN, instanceARN, d.Timeout(schema.TimeoutDelete)); err != nil {
-		return sdkdiag.AppendFromErr(diags, err)
+	if !d.Get("skip_provisioning").(bool) {
+		if err := provisionPermissionSet(ctx, conn, permissionSetARN, instanceARN, d.Timeout(schema.TimeoutDelete)); err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
 	}
 
 	return diags
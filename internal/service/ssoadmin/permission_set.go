@@ -92,6 +92,11 @@ func ResourcePermissionSet() *schema.Resource {
 				ValidateFunc: validation.StringLenBetween(1, 100),
 				Default:      "PT1H",
 			},
+			"skip_provisioning": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 			names.AttrTags:    tftags.TagsSchema(),
 			names.AttrTagsAll: tftags.TagsSchemaComputed(),
 		},
@@ -163,6 +168,9 @@ func resourcePermissionSetRead(ctx context.Context, d *schema.ResourceData, meta
 	d.Set(names.AttrName, permissionSet.Name)
 	d.Set("relay_state", permissionSet.RelayState)
 	d.Set("session_duration", permissionSet.SessionDuration)
+	if _, ok := d.GetOk("skip_provisioning"); !ok {
+		d.Set("skip_provisioning", false)
+	}
 
 	tags, err := listTags(ctx, conn, permissionSetARN, instanceARN)
 
@@ -213,9 +221,11 @@ func resourcePermissionSetUpdate(ctx context.Context, d *schema.ResourceData, me
 			return sdkdiag.AppendErrorf(diags, "updating SSO Permission Set (%s): %s", d.Id(), err)
 		}
 
-		// Re-provision ALL accounts after making the above changes
-		if err := provisionPermissionSet(ctx, conn, permissionSetARN, instanceARN, d.Timeout(schema.TimeoutUpdate)); err != nil {
-			return sdkdiag.AppendFromErr(diags, err)
+		// Re-provision ALL accounts after making the above changes, unless provisioning is managed separately.
+		if !d.Get("skip_provisioning").(bool) {
+			if err := provisionPermissionSet(ctx, conn, permissionSetARN, instanceARN, d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return sdkdiag.AppendFromErr(diags, err)
+			}
 		}
 	}
 
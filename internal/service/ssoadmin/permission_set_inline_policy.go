@@ -64,6 +64,11 @@ func ResourcePermissionSetInlinePolicy() *schema.Resource {
 				ForceNew:     true,
 				ValidateFunc: verify.ValidARN,
 			},
+			"skip_provisioning": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 		},
 	}
 }
@@ -72,6 +77,11 @@ func resourcePermissionSetInlinePolicyPut(ctx context.Context, d *schema.Resourc
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).SSOAdminClient(ctx)
 
+	// Only skip_provisioning has changed.
+	if !d.IsNewResource() && !d.HasChange("inline_policy") {
+		return append(diags, resourcePermissionSetInlinePolicyRead(ctx, d, meta)...)
+	}
+
 	policy, err := structure.NormalizeJsonString(d.Get("inline_policy").(string))
 	if err != nil {
 		return sdkdiag.AppendFromErr(diags, err)
@@ -94,8 +104,10 @@ func resourcePermissionSetInlinePolicyPut(ctx context.Context, d *schema.Resourc
 	d.SetId(fmt.Sprintf("%s,%s", permissionSetARN, instanceARN))
 
 	// (Re)provision ALL accounts after making the above changes.
-	if err := provisionPermissionSet(ctx, conn, permissionSetARN, instanceARN, d.Timeout(schema.TimeoutCreate)); err != nil {
-		return sdkdiag.AppendFromErr(diags, err)
+	if !d.Get("skip_provisioning").(bool) {
+		if err := provisionPermissionSet(ctx, conn, permissionSetARN, instanceARN, d.Timeout(schema.TimeoutCreate)); err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
 	}
 
 	return append(diags, resourcePermissionSetInlinePolicyRead(ctx, d, meta)...)
@@ -130,6 +142,9 @@ func resourcePermissionSetInlinePolicyRead(ctx context.Context, d *schema.Resour
 	d.Set("inline_policy", policyToSet)
 	d.Set("instance_arn", instanceARN)
 	d.Set("permission_set_arn", permissionSetARN)
+	if _, ok := d.GetOk("skip_provisioning"); !ok {
+		d.Set("skip_provisioning", false)
+	}
 
 	return diags
 }
@@ -159,8 +174,10 @@ func resourcePermissionSetInlinePolicyDelete(ctx context.Context, d *schema.Reso
 	}
 
 	// (Re)provision ALL accounts after making the above changes.
-	if err := provisionPermissionSet(ctx, conn, permissionSetARN, instanceARN, d.Timeout(schema.TimeoutDelete)); err != nil {
-		return sdkdiag.AppendFromErr(diags, err)
+	if !d.Get("skip_provisioning").(bool) {
+		if err := provisionPermissionSet(ctx, conn, permissionSetARN, instanceARN, d.Timeout(schema.TimeoutDelete)); err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
 	}
 
 	return diags
@@ -0,0 +1,189 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ssoadmin
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ssoadmin"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ssoadmin/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// @SDKResource("aws_ssoadmin_permission_set_provisioning")
+func ResourcePermissionSetProvisioning() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourcePermissionSetProvisioningCreate,
+		ReadWithoutTimeout:   resourcePermissionSetProvisioningRead,
+		UpdateWithoutTimeout: resourcePermissionSetProvisioningUpdate,
+		DeleteWithoutTimeout: resourcePermissionSetProvisioningDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"instance_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"permission_set_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"target_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidAccountID,
+			},
+			"triggers": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func resourcePermissionSetProvisioningCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SSOAdminClient(ctx)
+
+	instanceARN := d.Get("instance_arn").(string)
+	permissionSetARN := d.Get("permission_set_arn").(string)
+	targetID := d.Get("target_id").(string)
+
+	if err := provisionPermissionSetToTarget(ctx, conn, permissionSetARN, instanceARN, targetID, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	d.SetId(PermissionSetProvisioningCreateResourceID(permissionSetARN, instanceARN, targetID))
+
+	return append(diags, resourcePermissionSetProvisioningRead(ctx, d, meta)...)
+}
+
+func resourcePermissionSetProvisioningRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SSOAdminClient(ctx)
+
+	permissionSetARN, instanceARN, targetID, err := PermissionSetProvisioningParseResourceID(d.Id())
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	_, err = FindPermissionSet(ctx, conn, permissionSetARN, instanceARN)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] SSO Permission Set (%s) not found, removing provisioning from state", permissionSetARN)
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading SSO Permission Set (%s): %s", permissionSetARN, err)
+	}
+
+	d.Set("instance_arn", instanceARN)
+	d.Set("permission_set_arn", permissionSetARN)
+	if targetID != "" {
+		d.Set("target_id", targetID)
+	}
+
+	return diags
+}
+
+func resourcePermissionSetProvisioningUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SSOAdminClient(ctx)
+
+	permissionSetARN, instanceARN, targetID, err := PermissionSetProvisioningParseResourceID(d.Id())
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	if d.HasChange("triggers") {
+		if err := provisionPermissionSetToTarget(ctx, conn, permissionSetARN, instanceARN, targetID, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+	}
+
+	return append(diags, resourcePermissionSetProvisioningRead(ctx, d, meta)...)
+}
+
+func resourcePermissionSetProvisioningDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// Provisioning can't be undone, so removing the resource only removes it from state.
+	log.Printf("[DEBUG] Removing SSO Permission Set Provisioning (%s) from state", d.Id())
+
+	return nil
+}
+
+const permissionSetProvisioningIDSeparator = ","
+
+func PermissionSetProvisioningCreateResourceID(permissionSetARN, instanceARN, targetID string) string {
+	parts := []string{permissionSetARN, instanceARN}
+
+	if targetID != "" {
+		parts = append(parts, targetID)
+	}
+
+	return strings.Join(parts, permissionSetProvisioningIDSeparator)
+}
+
+func PermissionSetProvisioningParseResourceID(id string) (string, string, string, error) {
+	parts := strings.Split(id, permissionSetProvisioningIDSeparator)
+
+	switch {
+	case len(parts) == 2 && parts[0] != "" && parts[1] != "":
+		return parts[0], parts[1], "", nil
+	case len(parts) == 3 && parts[0] != "" && parts[1] != "" && parts[2] != "":
+		return parts[0], parts[1], parts[2], nil
+	}
+
+	return "", "", "", fmt.Errorf("parsing ID: expected PERMISSION_SET_ARN,INSTANCE_ARN or PERMISSION_SET_ARN,INSTANCE_ARN,TARGET_ID")
+}
+
+func provisionPermissionSetToTarget(ctx context.Context, conn *ssoadmin.Client, permissionSetARN, instanceARN, targetID string, timeout time.Duration) error {
+	if targetID == "" {
+		return provisionPermissionSet(ctx, conn, permissionSetARN, instanceARN, timeout)
+	}
+
+	input := &ssoadmin.ProvisionPermissionSetInput{
+		InstanceArn:      aws.String(instanceARN),
+		PermissionSetArn: aws.String(permissionSetARN),
+		TargetId:         aws.String(targetID),
+		TargetType:       awstypes.ProvisionTargetTypeAwsAccount,
+	}
+
+	output, err := conn.ProvisionPermissionSet(ctx, input)
+
+	if err != nil {
+		return fmt.Errorf("provisioning SSO Permission Set (%s) to account (%s): %w", permissionSetARN, targetID, err)
+	}
+
+	if _, err := waitPermissionSetProvisioned(ctx, conn, instanceARN, aws.ToString(output.PermissionSetProvisioningStatus.RequestId), timeout); err != nil {
+		return fmt.Errorf("waiting for SSO Permission Set (%s) provision to account (%s): %w", permissionSetARN, targetID, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,158 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ssoadmin_test
+
+import (
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tfssoadmin "github.com/hashicorp/terraform-provider-aws/internal/service/ssoadmin"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccSSOAdminPermissionSetProvisioning_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_ssoadmin_permission_set_provisioning.test"
+	permissionSetResourceName := "aws_ssoadmin_permission_set.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckSSOAdminInstances(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SSOAdminServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPermissionSetDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPermissionSetProvisioningConfig_basic(rName, "PT1H"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "instance_arn", permissionSetResourceName, "instance_arn"),
+					resource.TestCheckResourceAttrPair(resourceName, "permission_set_arn", permissionSetResourceName, names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "triggers.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "triggers.session_duration", "PT1H"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"triggers"},
+			},
+			{
+				Config: testAccPermissionSetProvisioningConfig_basic(rName, "PT2H"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "triggers.session_duration", "PT2H"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSSOAdminPermissionSetProvisioning_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	permissionSetResourceName := "aws_ssoadmin_permission_set.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckSSOAdminInstances(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SSOAdminServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPermissionSetDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPermissionSetProvisioningConfig_basic(rName, "PT1H"),
+				Check: resource.ComposeTestCheckFunc(
+					// Removing the provisioning only removes it from state, so delete the permission set.
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfssoadmin.ResourcePermissionSet(), permissionSetResourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccSSOAdminPermissionSetProvisioning_skipProvisioning(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_ssoadmin_permission_set_provisioning.test"
+	permissionSetResourceName := "aws_ssoadmin_permission_set.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckSSOAdminInstances(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SSOAdminServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPermissionSetDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPermissionSetProvisioningConfig_skipProvisioning(rName, "PT1H"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(permissionSetResourceName, "skip_provisioning", acctest.CtTrue),
+					resource.TestCheckResourceAttr(resourceName, "triggers.session_duration", "PT1H"),
+				),
+			},
+			{
+				Config: testAccPermissionSetProvisioningConfig_skipProvisioning(rName, "PT2H"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(permissionSetResourceName, "session_duration", "PT2H"),
+					resource.TestCheckResourceAttr(resourceName, "triggers.session_duration", "PT2H"),
+				),
+			},
+		},
+	})
+}
+
+func testAccPermissionSetProvisioningConfig_basic(rName, sessionDuration string) string {
+	return fmt.Sprintf(`
+data "aws_ssoadmin_instances" "test" {}
+
+resource "aws_ssoadmin_permission_set" "test" {
+  name             = %[1]q
+  instance_arn     = tolist(data.aws_ssoadmin_instances.test.arns)[0]
+  session_duration = %[2]q
+}
+
+resource "aws_ssoadmin_permission_set_provisioning" "test" {
+  instance_arn       = aws_ssoadmin_permission_set.test.instance_arn
+  permission_set_arn = aws_ssoadmin_permission_set.test.arn
+
+  triggers = {
+    session_duration = aws_ssoadmin_permission_set.test.session_duration
+  }
+}
+`, rName, sessionDuration)
+}
+
+func testAccPermissionSetProvisioningConfig_skipProvisioning(rName, sessionDuration string) string {
+	return fmt.Sprintf(`
+data "aws_ssoadmin_instances" "test" {}
+
+resource "aws_ssoadmin_permission_set" "test" {
+  name              = %[1]q
+  instance_arn      = tolist(data.aws_ssoadmin_instances.test.arns)[0]
+  session_duration  = %[2]q
+  skip_provisioning = true
+}
+
+resource "aws_ssoadmin_managed_policy_attachment" "test" {
+  instance_arn       = aws_ssoadmin_permission_set.test.instance_arn
+  managed_policy_arn = "arn:${data.aws_partition.current.partition}:iam::aws:policy/ReadOnlyAccess"
+  permission_set_arn = aws_ssoadmin_permission_set.test.arn
+  skip_provisioning  = true
+}
+
+data "aws_partition" "current" {}
+
+resource "aws_ssoadmin_permission_set_provisioning" "test" {
+  instance_arn       = aws_ssoadmin_permission_set.test.instance_arn
+  permission_set_arn = aws_ssoadmin_permission_set.test.arn
+
+  triggers = {
+    managed_policy_arn = aws_ssoadmin_managed_policy_attachment.test.managed_policy_arn
+    session_duration   = aws_ssoadmin_permission_set.test.session_duration
+  }
+}
+`, rName, sessionDuration)
+}
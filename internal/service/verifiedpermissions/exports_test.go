@@ -5,11 +5,13 @@ package verifiedpermissions
 
 // Exports for use in tests only.
 var (
+	ResourceIdentitySource = newResourceIdentitySource
 	ResourcePolicy         = newResourcePolicy
 	ResourcePolicyStore    = newResourcePolicyStore
 	ResourcePolicyTemplate = newResourcePolicyTemplate
 	ResourceSchema         = newResourceSchema
 
+	FindIdentitySourceByID    = findIdentitySourceByID
 	FindPolicyByID            = findPolicyByID
 	FindPolicyStoreByID       = findPolicyStoreByID
 	FindPolicyTemplateByID    = findPolicyTemplateByID
@@ -17,5 +19,8 @@ var (
 )
 
 var (
-	PolicyTemplateParseID = policyTemplateParseID
+	IdentitySourceParseID       = identitySourceParseID
+	ParsePolicyValidationSchema = parsePolicyValidationSchema
+	PolicyTemplateParseID       = policyTemplateParseID
+	ValidatePolicyStatement     = validatePolicyStatement
 )
@@ -0,0 +1,743 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package verifiedpermissions
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/verifiedpermissions"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/verifiedpermissions/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Identity Source")
+func newResourceIdentitySource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &resourceIdentitySource{}
+
+	return r, nil
+}
+
+const (
+	ResNameIdentitySource = "Identity Source"
+)
+
+type resourceIdentitySource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+}
+
+func (r *resourceIdentitySource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_verifiedpermissions_identity_source"
+}
+
+func (r *resourceIdentitySource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	s := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrID: framework.IDAttribute(),
+			"identity_source_id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"policy_store_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"principal_entity_type": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			names.AttrConfiguration: schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[identitySourceConfiguration](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+					listvalidator.IsRequired(),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						"cognito_user_pool_configuration": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[cognitoUserPoolConfiguration](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+								listvalidator.ExactlyOneOf(
+									path.MatchRelative().AtParent().AtName("open_id_connect_configuration"),
+								),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"client_ids": schema.ListAttribute{
+										ElementType: types.StringType,
+										Optional:    true,
+									},
+									"user_pool_arn": schema.StringAttribute{
+										CustomType: fwtypes.ARNType,
+										Required:   true,
+									},
+								},
+								Blocks: map[string]schema.Block{
+									"group_configuration": schema.ListNestedBlock{
+										CustomType: fwtypes.NewListNestedObjectTypeOf[cognitoGroupConfiguration](ctx),
+										Validators: []validator.List{
+											listvalidator.SizeAtMost(1),
+										},
+										NestedObject: schema.NestedBlockObject{
+											Attributes: map[string]schema.Attribute{
+												"group_entity_type": schema.StringAttribute{
+													Required: true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"open_id_connect_configuration": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[openIDConnectConfiguration](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+								listvalidator.ExactlyOneOf(
+									path.MatchRelative().AtParent().AtName("cognito_user_pool_configuration"),
+								),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"entity_id_prefix": schema.StringAttribute{
+										Optional: true,
+									},
+									"issuer": schema.StringAttribute{
+										Required: true,
+									},
+								},
+								Blocks: map[string]schema.Block{
+									"group_configuration": schema.ListNestedBlock{
+										CustomType: fwtypes.NewListNestedObjectTypeOf[openIDConnectGroupConfiguration](ctx),
+										Validators: []validator.List{
+											listvalidator.SizeAtMost(1),
+										},
+										NestedObject: schema.NestedBlockObject{
+											Attributes: map[string]schema.Attribute{
+												"group_claim": schema.StringAttribute{
+													Required: true,
+												},
+												"group_entity_type": schema.StringAttribute{
+													Required: true,
+												},
+											},
+										},
+									},
+									"token_selection": schema.ListNestedBlock{
+										CustomType: fwtypes.NewListNestedObjectTypeOf[openIDConnectTokenSelection](ctx),
+										Validators: []validator.List{
+											listvalidator.SizeAtMost(1),
+											listvalidator.IsRequired(),
+										},
+										NestedObject: schema.NestedBlockObject{
+											Blocks: map[string]schema.Block{
+												"access_token_only": schema.ListNestedBlock{
+													CustomType: fwtypes.NewListNestedObjectTypeOf[openIDConnectAccessTokenConfiguration](ctx),
+													Validators: []validator.List{
+														listvalidator.SizeAtMost(1),
+														listvalidator.ExactlyOneOf(
+															path.MatchRelative().AtParent().AtName("identity_token_only"),
+														),
+													},
+													NestedObject: schema.NestedBlockObject{
+														Attributes: map[string]schema.Attribute{
+															"audiences": schema.ListAttribute{
+																ElementType: types.StringType,
+																Optional:    true,
+															},
+															"principal_id_claim": schema.StringAttribute{
+																Optional: true,
+																Computed: true,
+																PlanModifiers: []planmodifier.String{
+																	stringplanmodifier.UseStateForUnknown(),
+																},
+															},
+														},
+													},
+												},
+												"identity_token_only": schema.ListNestedBlock{
+													CustomType: fwtypes.NewListNestedObjectTypeOf[openIDConnectIdentityTokenConfiguration](ctx),
+													Validators: []validator.List{
+														listvalidator.SizeAtMost(1),
+														listvalidator.ExactlyOneOf(
+															path.MatchRelative().AtParent().AtName("access_token_only"),
+														),
+													},
+													NestedObject: schema.NestedBlockObject{
+														Attributes: map[string]schema.Attribute{
+															"client_ids": schema.ListAttribute{
+																ElementType: types.StringType,
+																Optional:    true,
+															},
+															"principal_id_claim": schema.StringAttribute{
+																Optional: true,
+																Computed: true,
+																PlanModifiers: []planmodifier.String{
+																	stringplanmodifier.UseStateForUnknown(),
+																},
+															},
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	response.Schema = s
+}
+
+func (r *resourceIdentitySource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	conn := r.Meta().VerifiedPermissionsClient(ctx)
+	var plan resourceIdentitySourceData
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &plan)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	configuration := expandIdentitySourceConfiguration(ctx, plan.Configuration, &response.Diagnostics)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	input := &verifiedpermissions.CreateIdentitySourceInput{
+		ClientToken:         aws.String(id.UniqueId()),
+		Configuration:       configuration,
+		PolicyStoreId:       fwflex.StringFromFramework(ctx, plan.PolicyStoreID),
+		PrincipalEntityType: fwflex.StringFromFramework(ctx, plan.PrincipalEntityType),
+	}
+
+	output, err := conn.CreateIdentitySource(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.VerifiedPermissions, create.ErrActionCreating, ResNameIdentitySource, plan.PolicyStoreID.ValueString(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	state := plan
+	state.ID = fwflex.StringValueToFramework(ctx, fmt.Sprintf("%s:%s", aws.ToString(output.PolicyStoreId), aws.ToString(output.IdentitySourceId)))
+	state.IdentitySourceID = fwflex.StringToFramework(ctx, output.IdentitySourceId)
+
+	out, err := findIdentitySourceByID(ctx, conn, aws.ToString(output.PolicyStoreId), aws.ToString(output.IdentitySourceId))
+
+	if err != nil {
+		response.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.VerifiedPermissions, create.ErrActionCreating, ResNameIdentitySource, state.ID.ValueString(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	state.PrincipalEntityType = fwflex.StringToFramework(ctx, out.PrincipalEntityType)
+	state.Configuration = flattenIdentitySourceConfiguration(ctx, out.Configuration)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &state)...)
+}
+
+func (r *resourceIdentitySource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	conn := r.Meta().VerifiedPermissionsClient(ctx)
+	var state resourceIdentitySourceData
+
+	response.Diagnostics.Append(request.State.Get(ctx, &state)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	policyStoreID, identitySourceID, err := identitySourceParseID(state.ID.ValueString())
+	if err != nil {
+		response.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.VerifiedPermissions, create.ErrActionReading, ResNameIdentitySource, state.ID.ValueString(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	output, err := findIdentitySourceByID(ctx, conn, policyStoreID, identitySourceID)
+
+	if tfresource.NotFound(err) {
+		response.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.VerifiedPermissions, create.ErrActionReading, ResNameIdentitySource, state.ID.ValueString(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	state.IdentitySourceID = fwflex.StringToFramework(ctx, output.IdentitySourceId)
+	state.PolicyStoreID = fwflex.StringToFramework(ctx, output.PolicyStoreId)
+	state.PrincipalEntityType = fwflex.StringToFramework(ctx, output.PrincipalEntityType)
+	state.Configuration = flattenIdentitySourceConfiguration(ctx, output.Configuration)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &state)...)
+}
+
+func (r *resourceIdentitySource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	conn := r.Meta().VerifiedPermissionsClient(ctx)
+	var state, plan resourceIdentitySourceData
+
+	response.Diagnostics.Append(request.State.Get(ctx, &state)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &plan)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.Configuration.Equal(state.Configuration) || !plan.PrincipalEntityType.Equal(state.PrincipalEntityType) {
+		configuration := expandIdentitySourceUpdateConfiguration(ctx, plan.Configuration, &response.Diagnostics)
+
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		input := &verifiedpermissions.UpdateIdentitySourceInput{
+			IdentitySourceId:    fwflex.StringFromFramework(ctx, state.IdentitySourceID),
+			PolicyStoreId:       fwflex.StringFromFramework(ctx, state.PolicyStoreID),
+			PrincipalEntityType: fwflex.StringFromFramework(ctx, plan.PrincipalEntityType),
+			UpdateConfiguration: configuration,
+		}
+
+		_, err := conn.UpdateIdentitySource(ctx, input)
+
+		if err != nil {
+			response.Diagnostics.AddError(
+				create.ProblemStandardMessage(names.VerifiedPermissions, create.ErrActionUpdating, ResNameIdentitySource, state.ID.ValueString(), err),
+				err.Error(),
+			)
+			return
+		}
+
+		out, err := findIdentitySourceByID(ctx, conn, state.PolicyStoreID.ValueString(), state.IdentitySourceID.ValueString())
+
+		if err != nil {
+			response.Diagnostics.AddError(
+				create.ProblemStandardMessage(names.VerifiedPermissions, create.ErrActionUpdating, ResNameIdentitySource, state.ID.ValueString(), err),
+				err.Error(),
+			)
+			return
+		}
+
+		plan.PrincipalEntityType = fwflex.StringToFramework(ctx, out.PrincipalEntityType)
+		plan.Configuration = flattenIdentitySourceConfiguration(ctx, out.Configuration)
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &plan)...)
+}
+
+func (r *resourceIdentitySource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	conn := r.Meta().VerifiedPermissionsClient(ctx)
+	var state resourceIdentitySourceData
+
+	response.Diagnostics.Append(request.State.Get(ctx, &state)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "deleting Verified Permissions Identity Source", map[string]interface{}{
+		names.AttrID: state.ID.ValueString(),
+	})
+
+	input := &verifiedpermissions.DeleteIdentitySourceInput{
+		IdentitySourceId: aws.String(state.IdentitySourceID.ValueString()),
+		PolicyStoreId:    aws.String(state.PolicyStoreID.ValueString()),
+	}
+
+	_, err := conn.DeleteIdentitySource(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.VerifiedPermissions, create.ErrActionDeleting, ResNameIdentitySource, state.ID.ValueString(), err),
+			err.Error(),
+		)
+		return
+	}
+}
+
+type resourceIdentitySourceData struct {
+	Configuration       fwtypes.ListNestedObjectValueOf[identitySourceConfiguration] `tfsdk:"configuration"`
+	ID                  types.String                                                 `tfsdk:"id"`
+	IdentitySourceID    types.String                                                 `tfsdk:"identity_source_id"`
+	PolicyStoreID       types.String                                                 `tfsdk:"policy_store_id"`
+	PrincipalEntityType types.String                                                 `tfsdk:"principal_entity_type"`
+}
+
+type identitySourceConfiguration struct {
+	CognitoUserPoolConfiguration fwtypes.ListNestedObjectValueOf[cognitoUserPoolConfiguration] `tfsdk:"cognito_user_pool_configuration"`
+	OpenIDConnectConfiguration   fwtypes.ListNestedObjectValueOf[openIDConnectConfiguration]   `tfsdk:"open_id_connect_configuration"`
+}
+
+type cognitoUserPoolConfiguration struct {
+	ClientIDs          types.List                                                 `tfsdk:"client_ids"`
+	GroupConfiguration fwtypes.ListNestedObjectValueOf[cognitoGroupConfiguration] `tfsdk:"group_configuration"`
+	UserPoolARN        fwtypes.ARN                                                `tfsdk:"user_pool_arn"`
+}
+
+type cognitoGroupConfiguration struct {
+	GroupEntityType types.String `tfsdk:"group_entity_type"`
+}
+
+type openIDConnectConfiguration struct {
+	EntityIDPrefix     types.String                                                     `tfsdk:"entity_id_prefix"`
+	GroupConfiguration fwtypes.ListNestedObjectValueOf[openIDConnectGroupConfiguration] `tfsdk:"group_configuration"`
+	Issuer             types.String                                                     `tfsdk:"issuer"`
+	TokenSelection     fwtypes.ListNestedObjectValueOf[openIDConnectTokenSelection]     `tfsdk:"token_selection"`
+}
+
+type openIDConnectGroupConfiguration struct {
+	GroupClaim      types.String `tfsdk:"group_claim"`
+	GroupEntityType types.String `tfsdk:"group_entity_type"`
+}
+
+type openIDConnectTokenSelection struct {
+	AccessTokenOnly   fwtypes.ListNestedObjectValueOf[openIDConnectAccessTokenConfiguration]   `tfsdk:"access_token_only"`
+	IdentityTokenOnly fwtypes.ListNestedObjectValueOf[openIDConnectIdentityTokenConfiguration] `tfsdk:"identity_token_only"`
+}
+
+type openIDConnectAccessTokenConfiguration struct {
+	Audiences        types.List   `tfsdk:"audiences"`
+	PrincipalIDClaim types.String `tfsdk:"principal_id_claim"`
+}
+
+type openIDConnectIdentityTokenConfiguration struct {
+	ClientIDs        types.List   `tfsdk:"client_ids"`
+	PrincipalIDClaim types.String `tfsdk:"principal_id_claim"`
+}
+
+func findIdentitySourceByID(ctx context.Context, conn *verifiedpermissions.Client, policyStoreID, id string) (*verifiedpermissions.GetIdentitySourceOutput, error) {
+	in := &verifiedpermissions.GetIdentitySourceInput{
+		IdentitySourceId: aws.String(id),
+		PolicyStoreId:    aws.String(policyStoreID),
+	}
+
+	out, err := conn.GetIdentitySource(ctx, in)
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: in,
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if out == nil || out.IdentitySourceId == nil {
+		return nil, tfresource.NewEmptyResultError(in)
+	}
+
+	return out, nil
+}
+
+func identitySourceParseID(id string) (string, string, error) {
+	parts := strings.Split(id, ":")
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%s), expected POLICY-STORE-ID:IDENTITY-SOURCE-ID", id)
+}
+
+func expandIdentitySourceConfiguration(ctx context.Context, tfList fwtypes.ListNestedObjectValueOf[identitySourceConfiguration], diags *diag.Diagnostics) awstypes.Configuration {
+	configuration, d := tfList.ToPtr(ctx)
+	diags.Append(d...)
+	if diags.HasError() || configuration == nil {
+		return nil
+	}
+
+	if cognito := expandCognitoUserPoolConfiguration(ctx, configuration.CognitoUserPoolConfiguration, diags); cognito != nil {
+		return &awstypes.ConfigurationMemberCognitoUserPoolConfiguration{
+			Value: *cognito,
+		}
+	}
+
+	if oidc := expandOpenIDConnectConfiguration(ctx, configuration.OpenIDConnectConfiguration, diags); oidc != nil {
+		return &awstypes.ConfigurationMemberOpenIdConnectConfiguration{
+			Value: *oidc,
+		}
+	}
+
+	return nil
+}
+
+func expandIdentitySourceUpdateConfiguration(ctx context.Context, tfList fwtypes.ListNestedObjectValueOf[identitySourceConfiguration], diags *diag.Diagnostics) awstypes.UpdateConfiguration {
+	configuration, d := tfList.ToPtr(ctx)
+	diags.Append(d...)
+	if diags.HasError() || configuration == nil {
+		return nil
+	}
+
+	if cognito := expandCognitoUserPoolConfiguration(ctx, configuration.CognitoUserPoolConfiguration, diags); cognito != nil {
+		value := awstypes.UpdateCognitoUserPoolConfiguration{
+			ClientIds:   cognito.ClientIds,
+			UserPoolArn: cognito.UserPoolArn,
+		}
+
+		if cognito.GroupConfiguration != nil {
+			value.GroupConfiguration = &awstypes.UpdateCognitoGroupConfiguration{
+				GroupEntityType: cognito.GroupConfiguration.GroupEntityType,
+			}
+		}
+
+		return &awstypes.UpdateConfigurationMemberCognitoUserPoolConfiguration{
+			Value: value,
+		}
+	}
+
+	if oidc := expandOpenIDConnectConfiguration(ctx, configuration.OpenIDConnectConfiguration, diags); oidc != nil {
+		value := awstypes.UpdateOpenIdConnectConfiguration{
+			EntityIdPrefix: oidc.EntityIdPrefix,
+			Issuer:         oidc.Issuer,
+		}
+
+		if oidc.GroupConfiguration != nil {
+			value.GroupConfiguration = &awstypes.UpdateOpenIdConnectGroupConfiguration{
+				GroupClaim:      oidc.GroupConfiguration.GroupClaim,
+				GroupEntityType: oidc.GroupConfiguration.GroupEntityType,
+			}
+		}
+
+		switch v := oidc.TokenSelection.(type) {
+		case *awstypes.OpenIdConnectTokenSelectionMemberAccessTokenOnly:
+			value.TokenSelection = &awstypes.UpdateOpenIdConnectTokenSelectionMemberAccessTokenOnly{
+				Value: awstypes.UpdateOpenIdConnectAccessTokenConfiguration{
+					Audiences:        v.Value.Audiences,
+					PrincipalIdClaim: v.Value.PrincipalIdClaim,
+				},
+			}
+		case *awstypes.OpenIdConnectTokenSelectionMemberIdentityTokenOnly:
+			value.TokenSelection = &awstypes.UpdateOpenIdConnectTokenSelectionMemberIdentityTokenOnly{
+				Value: awstypes.UpdateOpenIdConnectIdentityTokenConfiguration{
+					ClientIds:        v.Value.ClientIds,
+					PrincipalIdClaim: v.Value.PrincipalIdClaim,
+				},
+			}
+		}
+
+		return &awstypes.UpdateConfigurationMemberOpenIdConnectConfiguration{
+			Value: value,
+		}
+	}
+
+	return nil
+}
+
+func expandCognitoUserPoolConfiguration(ctx context.Context, tfList fwtypes.ListNestedObjectValueOf[cognitoUserPoolConfiguration], diags *diag.Diagnostics) *awstypes.CognitoUserPoolConfiguration {
+	cognito, d := tfList.ToPtr(ctx)
+	diags.Append(d...)
+	if diags.HasError() || cognito == nil {
+		return nil
+	}
+
+	out := &awstypes.CognitoUserPoolConfiguration{
+		ClientIds:   fwflex.ExpandFrameworkStringValueList(ctx, cognito.ClientIDs),
+		UserPoolArn: fwflex.StringFromFramework(ctx, cognito.UserPoolARN),
+	}
+
+	group, d := cognito.GroupConfiguration.ToPtr(ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return nil
+	}
+
+	if group != nil {
+		out.GroupConfiguration = &awstypes.CognitoGroupConfiguration{
+			GroupEntityType: fwflex.StringFromFramework(ctx, group.GroupEntityType),
+		}
+	}
+
+	return out
+}
+
+func expandOpenIDConnectConfiguration(ctx context.Context, tfList fwtypes.ListNestedObjectValueOf[openIDConnectConfiguration], diags *diag.Diagnostics) *awstypes.OpenIdConnectConfiguration {
+	oidc, d := tfList.ToPtr(ctx)
+	diags.Append(d...)
+	if diags.HasError() || oidc == nil {
+		return nil
+	}
+
+	out := &awstypes.OpenIdConnectConfiguration{
+		EntityIdPrefix: fwflex.StringFromFramework(ctx, oidc.EntityIDPrefix),
+		Issuer:         fwflex.StringFromFramework(ctx, oidc.Issuer),
+	}
+
+	group, d := oidc.GroupConfiguration.ToPtr(ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return nil
+	}
+
+	if group != nil {
+		out.GroupConfiguration = &awstypes.OpenIdConnectGroupConfiguration{
+			GroupClaim:      fwflex.StringFromFramework(ctx, group.GroupClaim),
+			GroupEntityType: fwflex.StringFromFramework(ctx, group.GroupEntityType),
+		}
+	}
+
+	tokenSelection, d := oidc.TokenSelection.ToPtr(ctx)
+	diags.Append(d...)
+	if diags.HasError() || tokenSelection == nil {
+		return nil
+	}
+
+	accessToken, d := tokenSelection.AccessTokenOnly.ToPtr(ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return nil
+	}
+
+	identityToken, d := tokenSelection.IdentityTokenOnly.ToPtr(ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return nil
+	}
+
+	switch {
+	case accessToken != nil:
+		out.TokenSelection = &awstypes.OpenIdConnectTokenSelectionMemberAccessTokenOnly{
+			Value: awstypes.OpenIdConnectAccessTokenConfiguration{
+				Audiences:        fwflex.ExpandFrameworkStringValueList(ctx, accessToken.Audiences),
+				PrincipalIdClaim: fwflex.StringFromFramework(ctx, accessToken.PrincipalIDClaim),
+			},
+		}
+	case identityToken != nil:
+		out.TokenSelection = &awstypes.OpenIdConnectTokenSelectionMemberIdentityTokenOnly{
+			Value: awstypes.OpenIdConnectIdentityTokenConfiguration{
+				ClientIds:        fwflex.ExpandFrameworkStringValueList(ctx, identityToken.ClientIDs),
+				PrincipalIdClaim: fwflex.StringFromFramework(ctx, identityToken.PrincipalIDClaim),
+			},
+		}
+	}
+
+	return out
+}
+
+func flattenIdentitySourceConfiguration(ctx context.Context, apiObject awstypes.ConfigurationDetail) fwtypes.ListNestedObjectValueOf[identitySourceConfiguration] {
+	configuration := &identitySourceConfiguration{
+		CognitoUserPoolConfiguration: fwtypes.NewListNestedObjectValueOfNull[cognitoUserPoolConfiguration](ctx),
+		OpenIDConnectConfiguration:   fwtypes.NewListNestedObjectValueOfNull[openIDConnectConfiguration](ctx),
+	}
+
+	switch v := apiObject.(type) {
+	case *awstypes.ConfigurationDetailMemberCognitoUserPoolConfiguration:
+		configuration.CognitoUserPoolConfiguration = flattenCognitoUserPoolConfigurationDetail(ctx, &v.Value)
+	case *awstypes.ConfigurationDetailMemberOpenIdConnectConfiguration:
+		configuration.OpenIDConnectConfiguration = flattenOpenIDConnectConfigurationDetail(ctx, &v.Value)
+	default:
+		return fwtypes.NewListNestedObjectValueOfNull[identitySourceConfiguration](ctx)
+	}
+
+	return fwtypes.NewListNestedObjectValueOfPtrMust(ctx, configuration)
+}
+
+func flattenCognitoUserPoolConfigurationDetail(ctx context.Context, apiObject *awstypes.CognitoUserPoolConfigurationDetail) fwtypes.ListNestedObjectValueOf[cognitoUserPoolConfiguration] {
+	cognito := &cognitoUserPoolConfiguration{
+		ClientIDs:          fwflex.FlattenFrameworkStringValueList(ctx, apiObject.ClientIds),
+		GroupConfiguration: fwtypes.NewListNestedObjectValueOfNull[cognitoGroupConfiguration](ctx),
+		UserPoolARN:        fwflex.StringToFrameworkARN(ctx, apiObject.UserPoolArn),
+	}
+
+	if group := apiObject.GroupConfiguration; group != nil {
+		cognito.GroupConfiguration = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &cognitoGroupConfiguration{
+			GroupEntityType: fwflex.StringToFramework(ctx, group.GroupEntityType),
+		})
+	}
+
+	return fwtypes.NewListNestedObjectValueOfPtrMust(ctx, cognito)
+}
+
+func flattenOpenIDConnectConfigurationDetail(ctx context.Context, apiObject *awstypes.OpenIdConnectConfigurationDetail) fwtypes.ListNestedObjectValueOf[openIDConnectConfiguration] {
+	oidc := &openIDConnectConfiguration{
+		EntityIDPrefix:     fwflex.StringToFramework(ctx, apiObject.EntityIdPrefix),
+		GroupConfiguration: fwtypes.NewListNestedObjectValueOfNull[openIDConnectGroupConfiguration](ctx),
+		Issuer:             fwflex.StringToFramework(ctx, apiObject.Issuer),
+		TokenSelection:     fwtypes.NewListNestedObjectValueOfNull[openIDConnectTokenSelection](ctx),
+	}
+
+	if group := apiObject.GroupConfiguration; group != nil {
+		oidc.GroupConfiguration = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &openIDConnectGroupConfiguration{
+			GroupClaim:      fwflex.StringToFramework(ctx, group.GroupClaim),
+			GroupEntityType: fwflex.StringToFramework(ctx, group.GroupEntityType),
+		})
+	}
+
+	tokenSelection := &openIDConnectTokenSelection{
+		AccessTokenOnly:   fwtypes.NewListNestedObjectValueOfNull[openIDConnectAccessTokenConfiguration](ctx),
+		IdentityTokenOnly: fwtypes.NewListNestedObjectValueOfNull[openIDConnectIdentityTokenConfiguration](ctx),
+	}
+
+	switch v := apiObject.TokenSelection.(type) {
+	case *awstypes.OpenIdConnectTokenSelectionDetailMemberAccessTokenOnly:
+		tokenSelection.AccessTokenOnly = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &openIDConnectAccessTokenConfiguration{
+			Audiences:        fwflex.FlattenFrameworkStringValueList(ctx, v.Value.Audiences),
+			PrincipalIDClaim: fwflex.StringToFramework(ctx, v.Value.PrincipalIdClaim),
+		})
+		oidc.TokenSelection = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, tokenSelection)
+	case *awstypes.OpenIdConnectTokenSelectionDetailMemberIdentityTokenOnly:
+		tokenSelection.IdentityTokenOnly = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &openIDConnectIdentityTokenConfiguration{
+			ClientIDs:        fwflex.FlattenFrameworkStringValueList(ctx, v.Value.ClientIds),
+			PrincipalIDClaim: fwflex.StringToFramework(ctx, v.Value.PrincipalIdClaim),
+		})
+		oidc.TokenSelection = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, tokenSelection)
+	}
+
+	return fwtypes.NewListNestedObjectValueOfPtrMust(ctx, oidc)
+}
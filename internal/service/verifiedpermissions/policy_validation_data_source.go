@@ -0,0 +1,325 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package verifiedpermissions
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	cedar "github.com/cedar-policy/cedar-go/x/exp/parser"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource(name="Policy Validation")
+func newDataSourcePolicyValidation(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &dataSourcePolicyValidation{}, nil
+}
+
+const (
+	DSNamePolicyValidation = "Policy Validation Data Source"
+)
+
+type dataSourcePolicyValidation struct {
+	framework.DataSourceWithConfigure
+}
+
+func (d *dataSourcePolicyValidation) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) { // nosemgrep:ci.meta-in-func-name
+	resp.TypeName = "aws_verifiedpermissions_policy_validation"
+}
+
+func (d *dataSourcePolicyValidation) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"errors": schema.ListAttribute{
+				CustomType:  fwtypes.NewListNestedObjectTypeOf[policyValidationError](ctx),
+				ElementType: fwtypes.NewObjectTypeOf[policyValidationError](ctx),
+				Computed:    true,
+			},
+			names.AttrID: framework.IDAttribute(),
+			"policy_store_id": schema.StringAttribute{
+				Required: true,
+			},
+			"statement": schema.StringAttribute{
+				Required: true,
+			},
+			"valid": schema.BoolAttribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+func (d *dataSourcePolicyValidation) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data dataSourcePolicyValidationData
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conn := d.Meta().VerifiedPermissionsClient(ctx)
+
+	out, err := findSchemaByPolicyStoreID(ctx, conn, data.PolicyStoreID.ValueString())
+
+	// A policy store without a schema can only be checked for syntax errors.
+	if tfresource.NotFound(err) {
+		_, err = findPolicyStoreByID(ctx, conn, data.PolicyStoreID.ValueString())
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.VerifiedPermissions, create.ErrActionReading, DSNamePolicyValidation, data.PolicyStoreID.ValueString(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	var cedarSchema *policyValidationSchema
+	if out != nil {
+		cedarSchema, err = parsePolicyValidationSchema(aws.ToString(out.Schema))
+
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("policy_store_id"),
+				create.ProblemStandardMessage(names.VerifiedPermissions, create.ErrActionReading, DSNamePolicyValidation, data.PolicyStoreID.ValueString(), err),
+				err.Error(),
+			)
+			return
+		}
+	}
+
+	validationErrors := validatePolicyStatement(data.Statement.ValueString(), cedarSchema)
+
+	data.ID = fwflex.StringValueToFramework(ctx, strconv.Itoa(create.StringHashcode(data.PolicyStoreID.ValueString()+data.Statement.ValueString())))
+	data.Valid = types.BoolValue(len(validationErrors) == 0)
+	data.Errors = fwtypes.NewListNestedObjectValueOfValueSliceMust(ctx, validationErrors)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+type dataSourcePolicyValidationData struct {
+	Errors        fwtypes.ListNestedObjectValueOf[policyValidationError] `tfsdk:"errors"`
+	ID            types.String                                           `tfsdk:"id"`
+	PolicyStoreID types.String                                           `tfsdk:"policy_store_id"`
+	Statement     types.String                                           `tfsdk:"statement"`
+	Valid         types.Bool                                             `tfsdk:"valid"`
+}
+
+type policyValidationError struct {
+	Code    types.String `tfsdk:"code"`
+	Column  types.Int64  `tfsdk:"column"`
+	Line    types.Int64  `tfsdk:"line"`
+	Message types.String `tfsdk:"message"`
+}
+
+const (
+	policyValidationErrorCodeInvalidActionApplication = "INVALID_ACTION_APPLICATION"
+	policyValidationErrorCodeSyntaxError              = "SYNTAX_ERROR"
+	policyValidationErrorCodeUnrecognizedActionID     = "UNRECOGNIZED_ACTION_ID"
+	policyValidationErrorCodeUnrecognizedEntityType   = "UNRECOGNIZED_ENTITY_TYPE"
+)
+
+// policyValidationSchema is the subset of a Cedar JSON schema needed to validate a policy's scope.
+type policyValidationSchema struct {
+	entityTypes map[string]struct{}
+	actions     map[string]policyValidationAction
+}
+
+type policyValidationAction struct {
+	principalTypes []string
+	resourceTypes  []string
+}
+
+type cedarSchemaNamespace struct {
+	EntityTypes map[string]json.RawMessage `json:"entityTypes"`
+	Actions     map[string]struct {
+		AppliesTo *struct {
+			PrincipalTypes []string `json:"principalTypes"`
+			ResourceTypes  []string `json:"resourceTypes"`
+		} `json:"appliesTo"`
+	} `json:"actions"`
+}
+
+func parsePolicyValidationSchema(s string) (*policyValidationSchema, error) {
+	var namespaces map[string]cedarSchemaNamespace
+
+	if err := json.Unmarshal([]byte(s), &namespaces); err != nil {
+		return nil, fmt.Errorf("parsing Cedar schema: %w", err)
+	}
+
+	out := &policyValidationSchema{
+		entityTypes: make(map[string]struct{}),
+		actions:     make(map[string]policyValidationAction),
+	}
+
+	for namespace, v := range namespaces {
+		qualify := func(name string) string {
+			if namespace == "" || strings.Contains(name, "::") {
+				return name
+			}
+			return namespace + "::" + name
+		}
+
+		for name := range v.EntityTypes {
+			out.entityTypes[qualify(name)] = struct{}{}
+		}
+
+		for name, action := range v.Actions {
+			var a policyValidationAction
+
+			// Omitted principal or resource types place no constraint on the policy scope.
+			if action.AppliesTo != nil {
+				for _, t := range action.AppliesTo.PrincipalTypes {
+					a.principalTypes = append(a.principalTypes, qualify(t))
+				}
+				for _, t := range action.AppliesTo.ResourceTypes {
+					a.resourceTypes = append(a.resourceTypes, qualify(t))
+				}
+			}
+
+			out.actions[qualify("Action")+"::"+strconv.Quote(name)] = a
+		}
+	}
+
+	return out, nil
+}
+
+var cedarErrorPositionRegexp = regexache.MustCompile(`<input>:(\d+):(\d+)`)
+
+// validatePolicyStatement parses a Cedar policy statement and, when the policy store has a schema,
+// checks the entity types and actions referenced in each policy's scope against it.
+func validatePolicyStatement(statement string, cedarSchema *policyValidationSchema) []policyValidationError {
+	tokens, err := cedar.Tokenize([]byte(statement))
+	if err != nil {
+		return []policyValidationError{newPolicyValidationSyntaxError(err)}
+	}
+
+	policies, err := cedar.Parse(tokens)
+	if err != nil {
+		return []policyValidationError{newPolicyValidationSyntaxError(err)}
+	}
+
+	if cedarSchema == nil {
+		return nil
+	}
+
+	var out []policyValidationError
+
+	for _, policy := range policies {
+		newError := func(code, format string, a ...any) {
+			out = append(out, policyValidationError{
+				Code:    types.StringValue(code),
+				Column:  types.Int64Value(int64(policy.Position.Column)),
+				Line:    types.Int64Value(int64(policy.Position.Line)),
+				Message: types.StringValue(fmt.Sprintf(format, a...)),
+			})
+		}
+
+		principalType := cedarScopeEntityType(policy.Principal.Type, policy.Principal.Path, policy.Principal.Entity)
+		resourceType := cedarScopeEntityType(policy.Resource.Type, policy.Resource.Path, policy.Resource.Entity)
+
+		for _, v := range cedarScopeReferencedTypes(policy.Principal.Type, policy.Principal.Path, policy.Principal.Entity) {
+			if _, ok := cedarSchema.entityTypes[v]; !ok {
+				newError(policyValidationErrorCodeUnrecognizedEntityType, "principal entity type %s is not defined in the schema", v)
+			}
+		}
+
+		for _, v := range cedarScopeReferencedTypes(policy.Resource.Type, policy.Resource.Path, policy.Resource.Entity) {
+			if _, ok := cedarSchema.entityTypes[v]; !ok {
+				newError(policyValidationErrorCodeUnrecognizedEntityType, "resource entity type %s is not defined in the schema", v)
+			}
+		}
+
+		for _, entity := range policy.Action.Entities {
+			key := entity.String()
+			action, ok := cedarSchema.actions[key]
+
+			if !ok {
+				newError(policyValidationErrorCodeUnrecognizedActionID, "action %s is not defined in the schema", key)
+				continue
+			}
+
+			// An "in" constraint refers to an action group, whose members are not known here.
+			if policy.Action.Type == cedar.MatchIn {
+				continue
+			}
+
+			if principalType != "" && action.principalTypes != nil && !slices.Contains(action.principalTypes, principalType) {
+				newError(policyValidationErrorCodeInvalidActionApplication, "action %s does not apply to principal type %s", key, principalType)
+			}
+
+			if resourceType != "" && action.resourceTypes != nil && !slices.Contains(action.resourceTypes, resourceType) {
+				newError(policyValidationErrorCodeInvalidActionApplication, "action %s does not apply to resource type %s", key, resourceType)
+			}
+		}
+	}
+
+	return out
+}
+
+func newPolicyValidationSyntaxError(err error) policyValidationError {
+	out := policyValidationError{
+		Code:    types.StringValue(policyValidationErrorCodeSyntaxError),
+		Column:  types.Int64Null(),
+		Line:    types.Int64Null(),
+		Message: types.StringValue(err.Error()),
+	}
+
+	if m := cedarErrorPositionRegexp.FindStringSubmatch(err.Error()); m != nil {
+		line, _ := strconv.ParseInt(m[1], 10, 64)
+		column, _ := strconv.ParseInt(m[2], 10, 64)
+		out.Line = types.Int64Value(line)
+		out.Column = types.Int64Value(column)
+	}
+
+	return out
+}
+
+// cedarScopeEntityType returns the entity type that a principal or resource scope is constrained to, if any.
+func cedarScopeEntityType(matchType cedar.MatchType, typePath cedar.Path, entity cedar.Entity) string {
+	switch matchType {
+	case cedar.MatchEquals:
+		return cedarEntityType(entity)
+	case cedar.MatchIs, cedar.MatchIsIn:
+		return strings.Join(typePath.Path, "::")
+	}
+
+	return ""
+}
+
+// cedarScopeReferencedTypes returns all entity types referenced by a principal or resource scope.
+func cedarScopeReferencedTypes(matchType cedar.MatchType, typePath cedar.Path, entity cedar.Entity) []string {
+	switch matchType {
+	case cedar.MatchEquals, cedar.MatchIn:
+		return []string{cedarEntityType(entity)}
+	case cedar.MatchIs:
+		return []string{strings.Join(typePath.Path, "::")}
+	case cedar.MatchIsIn:
+		return []string{strings.Join(typePath.Path, "::"), cedarEntityType(entity)}
+	}
+
+	return nil
+}
+
+func cedarEntityType(entity cedar.Entity) string {
+	if len(entity.Path) == 0 {
+		return ""
+	}
+
+	return strings.Join(entity.Path[:len(entity.Path)-1], "::")
+}
@@ -0,0 +1,233 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package verifiedpermissions_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tfverifiedpermissions "github.com/hashicorp/terraform-provider-aws/internal/service/verifiedpermissions"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const testAccPolicyValidationSchema = `{
+  "PhotoFlash": {
+    "entityTypes": {
+      "User": {
+        "memberOfTypes": ["UserGroup"]
+      },
+      "UserGroup": {},
+      "Photo": {}
+    },
+    "actions": {
+      "ViewPhoto": {
+        "appliesTo": {
+          "principalTypes": ["User", "UserGroup"],
+          "resourceTypes": ["Photo"]
+        }
+      },
+      "DeletePhoto": {
+        "appliesTo": {
+          "principalTypes": ["User"],
+          "resourceTypes": ["Photo"]
+        }
+      }
+    }
+  }
+}`
+
+func TestValidatePolicyStatement(t *testing.T) {
+	t.Parallel()
+
+	cedarSchema, err := tfverifiedpermissions.ParsePolicyValidationSchema(testAccPolicyValidationSchema)
+	if err != nil {
+		t.Fatalf("parsing schema: %s", err)
+	}
+
+	testCases := map[string]struct {
+		statement     string
+		withSchema    bool
+		expectedCodes []string
+		expectedLine  int64
+	}{
+		"valid without schema": {
+			statement: `permit (principal, action, resource);`,
+		},
+		"syntax error": {
+			statement:     "permit (principal, action, resource)\nwhen { principal.name == };",
+			expectedCodes: []string{"SYNTAX_ERROR"},
+			expectedLine:  2,
+		},
+		"unterminated string": {
+			statement:     `permit (principal == PhotoFlash::User::"alice, action, resource);`,
+			expectedCodes: []string{"SYNTAX_ERROR"},
+			expectedLine:  1,
+		},
+		"valid with schema": {
+			statement:  `permit (principal == PhotoFlash::User::"alice", action == PhotoFlash::Action::"ViewPhoto", resource is PhotoFlash::Photo);`,
+			withSchema: true,
+		},
+		"valid action list": {
+			statement:  `permit (principal is PhotoFlash::User in PhotoFlash::UserGroup::"admins", action in [PhotoFlash::Action::"ViewPhoto", PhotoFlash::Action::"DeletePhoto"], resource);`,
+			withSchema: true,
+		},
+		"unrecognized principal type": {
+			statement:     `permit (principal == PhotoFlash::Admin::"alice", action, resource);`,
+			withSchema:    true,
+			expectedCodes: []string{"UNRECOGNIZED_ENTITY_TYPE"},
+			expectedLine:  1,
+		},
+		"unrecognized resource type": {
+			statement:     "permit (principal, action, resource);\n\nforbid (principal, action, resource in PhotoFlash::Album::\"vacation\");",
+			withSchema:    true,
+			expectedCodes: []string{"UNRECOGNIZED_ENTITY_TYPE"},
+			expectedLine:  3,
+		},
+		"unrecognized action": {
+			statement:     `permit (principal, action == PhotoFlash::Action::"EditPhoto", resource);`,
+			withSchema:    true,
+			expectedCodes: []string{"UNRECOGNIZED_ACTION_ID"},
+			expectedLine:  1,
+		},
+		"invalid action application": {
+			statement:     `permit (principal in PhotoFlash::UserGroup::"admins", action in [PhotoFlash::Action::"DeletePhoto"], resource is PhotoFlash::User);`,
+			withSchema:    true,
+			expectedCodes: []string{"INVALID_ACTION_APPLICATION"},
+			expectedLine:  1,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			s := cedarSchema
+			if !testCase.withSchema {
+				s = nil
+			}
+
+			var got []string
+			var line int64
+			for _, v := range tfverifiedpermissions.ValidatePolicyStatement(testCase.statement, s) {
+				got = append(got, v.Code.ValueString())
+				line = v.Line.ValueInt64()
+			}
+
+			if fmt.Sprint(got) != fmt.Sprint(testCase.expectedCodes) {
+				t.Errorf("expected codes %v, got %v", testCase.expectedCodes, got)
+			}
+
+			if line != testCase.expectedLine {
+				t.Errorf("expected line %d, got %d", testCase.expectedLine, line)
+			}
+		})
+	}
+}
+
+func TestAccVerifiedPermissionsPolicyValidationDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_verifiedpermissions_policy_validation.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.VerifiedPermissionsEndpointID)
+			testAccPolicyStoresPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.VerifiedPermissionsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPolicyValidationDataSourceConfig_basic(`permit (principal == PhotoFlash::User::"alice", action == PhotoFlash::Action::"ViewPhoto", resource);`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "valid", "true"),
+					resource.TestCheckResourceAttr(dataSourceName, "errors.#", "0"),
+				),
+			},
+			{
+				Config: testAccPolicyValidationDataSourceConfig_basic(`permit (principal == PhotoFlash::User::"alice", action == PhotoFlash::Action::"EditPhoto", resource);`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "valid", "false"),
+					resource.TestCheckResourceAttr(dataSourceName, "errors.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "errors.0.code", "UNRECOGNIZED_ACTION_ID"),
+					resource.TestCheckResourceAttr(dataSourceName, "errors.0.line", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "errors.0.column", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccVerifiedPermissionsPolicyValidationDataSource_noSchema(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_verifiedpermissions_policy_validation.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.VerifiedPermissionsEndpointID)
+			testAccPolicyStoresPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.VerifiedPermissionsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPolicyValidationDataSourceConfig_noSchema(`permit (principal == PhotoFlash::User::"alice", action == PhotoFlash::Action::"EditPhoto", resource);`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "valid", "true"),
+					resource.TestCheckResourceAttr(dataSourceName, "errors.#", "0"),
+				),
+			},
+			{
+				Config: testAccPolicyValidationDataSourceConfig_noSchema(`permit (principal, action, resource`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "valid", "false"),
+					resource.TestCheckResourceAttr(dataSourceName, "errors.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "errors.0.code", "SYNTAX_ERROR"),
+				),
+			},
+		},
+	})
+}
+
+func testAccPolicyValidationDataSourceConfig_basic(statement string) string {
+	return fmt.Sprintf(`
+resource "aws_verifiedpermissions_policy_store" "test" {
+  validation_settings {
+    mode = "OFF"
+  }
+}
+
+resource "aws_verifiedpermissions_schema" "test" {
+  policy_store_id = aws_verifiedpermissions_policy_store.test.policy_store_id
+
+  definition {
+    value = jsonencode(jsondecode(%[2]q))
+  }
+}
+
+data "aws_verifiedpermissions_policy_validation" "test" {
+  policy_store_id = aws_verifiedpermissions_schema.test.policy_store_id
+  statement       = %[1]q
+}
+`, statement, testAccPolicyValidationSchema)
+}
+
+func testAccPolicyValidationDataSourceConfig_noSchema(statement string) string {
+	return fmt.Sprintf(`
+resource "aws_verifiedpermissions_policy_store" "test" {
+  validation_settings {
+    mode = "OFF"
+  }
+}
+
+data "aws_verifiedpermissions_policy_validation" "test" {
+  policy_store_id = aws_verifiedpermissions_policy_store.test.policy_store_id
+  statement       = %[1]q
+}
+`, statement)
+}
@@ -20,11 +20,19 @@ func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*types.Serv
 			Factory: newDataSourcePolicyStore,
 			Name:    "Policy Store",
 		},
+		{
+			Factory: newDataSourcePolicyValidation,
+			Name:    "Policy Validation",
+		},
 	}
 }
 
 func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.ServicePackageFrameworkResource {
 	return []*types.ServicePackageFrameworkResource{
+		{
+			Factory: newResourceIdentitySource,
+			Name:    "Identity Source",
+		},
 		{
 			Factory: newResourcePolicy,
 			Name:    "Policy",
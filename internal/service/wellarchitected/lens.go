@@ -0,0 +1,322 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package wellarchitected
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/wellarchitected"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/wellarchitected/types"
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	sdkid "github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	lensImportTimeout = 2 * time.Minute
+)
+
+// @FrameworkResource("aws_wellarchitected_lens", name="Lens")
+// @Tags(identifierAttribute="arn")
+func newLensResource(context.Context) (resource.ResourceWithConfigure, error) {
+	return &lensResource{}, nil
+}
+
+type lensResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+}
+
+func (*lensResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_wellarchitected_lens"
+}
+
+func (r *lensResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			names.AttrDescription: schema.StringAttribute{
+				Computed: true,
+			},
+			names.AttrID: framework.IDAttribute(),
+			"json_string": schema.StringAttribute{
+				CustomType: jsontypes.NormalizedType{},
+				Required:   true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(500000),
+				},
+			},
+			"lens_version": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 32),
+				},
+			},
+			names.AttrName: schema.StringAttribute{
+				Computed: true,
+			},
+			names.AttrOwner: schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+		},
+	}
+}
+
+func (r *lensResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data lensResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().WellArchitectedClient(ctx)
+
+	input := &wellarchitected.ImportLensInput{
+		ClientRequestToken: aws.String(sdkid.UniqueId()),
+		JSONString:         aws.String(data.JSONString.ValueString()),
+		Tags:               getTagsIn(ctx),
+	}
+
+	output, err := conn.ImportLens(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError("creating Well-Architected Lens", err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.ID = fwflex.StringToFramework(ctx, output.LensArn)
+
+	outputRaw, err := tfresource.RetryWhenNotFound(ctx, lensImportTimeout, func() (interface{}, error) {
+		return findLensByARN(ctx, conn, data.ID.ValueString())
+	})
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for Well-Architected Lens (%s) import", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	if !data.PublishedVersion.IsNull() {
+		if err := createLensVersion(ctx, conn, data.ID.ValueString(), data.PublishedVersion.ValueString()); err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("publishing Well-Architected Lens (%s)", data.ID.ValueString()), err.Error())
+
+			return
+		}
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, outputRaw.(*awstypes.LensSummary), &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *lensResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data lensResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().WellArchitectedClient(ctx)
+
+	output, err := findLensByARN(ctx, conn, data.ID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Well-Architected Lens (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *lensResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new lensResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().WellArchitectedClient(ctx)
+	arn := new.ID.ValueString()
+
+	if !new.JSONString.Equal(old.JSONString) {
+		// Re-importing a lens document into an existing lens updates its draft.
+		input := &wellarchitected.ImportLensInput{
+			ClientRequestToken: aws.String(sdkid.UniqueId()),
+			JSONString:         aws.String(new.JSONString.ValueString()),
+			LensAlias:          aws.String(arn),
+		}
+
+		_, err := conn.ImportLens(ctx, input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating Well-Architected Lens (%s)", arn), err.Error())
+
+			return
+		}
+	}
+
+	if !new.PublishedVersion.IsNull() && (!new.JSONString.Equal(old.JSONString) || !new.PublishedVersion.Equal(old.PublishedVersion)) {
+		if err := createLensVersion(ctx, conn, arn, new.PublishedVersion.ValueString()); err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("publishing Well-Architected Lens (%s)", arn), err.Error())
+
+			return
+		}
+	}
+
+	output, err := findLensByARN(ctx, conn, arn)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Well-Architected Lens (%s)", arn), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *lensResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data lensResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().WellArchitectedClient(ctx)
+
+	_, err := conn.DeleteLens(ctx, &wellarchitected.DeleteLensInput{
+		ClientRequestToken: aws.String(sdkid.UniqueId()),
+		LensAlias:          aws.String(data.ID.ValueString()),
+		LensStatus:         awstypes.LensStatusTypeAll,
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Well-Architected Lens (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func (r *lensResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	r.SetTagsAll(ctx, request, response)
+}
+
+// createLensVersion publishes the lens's draft.
+// Publishing fails with a conflict while a lens import is still in progress.
+func createLensVersion(ctx context.Context, conn *wellarchitected.Client, arn, version string) error {
+	input := &wellarchitected.CreateLensVersionInput{
+		ClientRequestToken: aws.String(sdkid.UniqueId()),
+		IsMajorVersion:     aws.Bool(true),
+		LensAlias:          aws.String(arn),
+		LensVersion:        aws.String(version),
+	}
+
+	_, err := tfresource.RetryWhenIsA[*awstypes.ConflictException](ctx, lensImportTimeout, func() (interface{}, error) {
+		return conn.CreateLensVersion(ctx, input)
+	})
+
+	return err
+}
+
+func findLensByARN(ctx context.Context, conn *wellarchitected.Client, arn string) (*awstypes.LensSummary, error) {
+	input := &wellarchitected.ListLensesInput{
+		LensStatus: awstypes.LensStatusTypeAll,
+		LensType:   awstypes.LensTypeCustomSelf,
+	}
+
+	output, err := findLenses(ctx, conn, input, func(v *awstypes.LensSummary) bool {
+		return aws.ToString(v.LensArn) == arn
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfresource.AssertFirstValueResult(output)
+}
+
+func findLenses(ctx context.Context, conn *wellarchitected.Client, input *wellarchitected.ListLensesInput, filter tfslices.Predicate[*awstypes.LensSummary]) ([]awstypes.LensSummary, error) {
+	var output []awstypes.LensSummary
+
+	pages := wellarchitected.NewListLensesPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.LensSummaries {
+			if filter(&v) {
+				output = append(output, v)
+			}
+		}
+	}
+
+	return output, nil
+}
+
+type lensResourceModel struct {
+	Description      types.String         `tfsdk:"description"`
+	ID               types.String         `tfsdk:"id"`
+	JSONString       jsontypes.Normalized `tfsdk:"json_string"`
+	LensARN          types.String         `tfsdk:"arn"`
+	LensName         types.String         `tfsdk:"name"`
+	Owner            types.String         `tfsdk:"owner"`
+	PublishedVersion types.String         `tfsdk:"lens_version"`
+	Tags             types.Map            `tfsdk:"tags"`
+	TagsAll          types.Map            `tfsdk:"tags_all"`
+}
@@ -0,0 +1,418 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package workspacesweb
+
+import (
+	"context"
+	"encoding/pem"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/workspacesweb"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/workspacesweb/types"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	sdkid "github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_workspacesweb_trust_store", name="Trust Store")
+// @Tags(identifierAttribute="arn")
+func newTrustStoreResource(context.Context) (resource.ResourceWithConfigure, error) {
+	return &trustStoreResource{}, nil
+}
+
+type trustStoreResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+}
+
+func (*trustStoreResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_workspacesweb_trust_store"
+}
+
+func (r *trustStoreResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			"associated_portal_arns": schema.ListAttribute{
+				CustomType:  fwtypes.ListOfStringType,
+				ElementType: types.StringType,
+				Computed:    true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"certificate": schema.ListAttribute{
+				CustomType:  fwtypes.NewListNestedObjectTypeOf[certificateSummaryModel](ctx),
+				ElementType: fwtypes.NewObjectTypeOf[certificateSummaryModel](ctx),
+				Computed:    true,
+			},
+			"certificate_list": schema.SetAttribute{
+				CustomType:  fwtypes.SetOfStringType,
+				ElementType: types.StringType,
+				Required:    true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+			names.AttrID:      framework.IDAttribute(),
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+		},
+	}
+}
+
+func (r *trustStoreResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data trustStoreResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().WorkSpacesWebClient(ctx)
+
+	input := &workspacesweb.CreateTrustStoreInput{
+		CertificateList: tfslices.ApplyToAll(fwflex.ExpandFrameworkStringValueSet(ctx, data.CertificateList), func(v string) []byte {
+			return []byte(v)
+		}),
+		ClientToken: aws.String(sdkid.UniqueId()),
+		Tags:        getTagsIn(ctx),
+	}
+
+	output, err := conn.CreateTrustStore(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError("creating WorkSpaces Web Trust Store", err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.ID = fwflex.StringToFramework(ctx, output.TrustStoreArn)
+	data.TrustStoreARN = data.ID
+
+	trustStore, err := findTrustStoreByARN(ctx, conn, data.ID.ValueString())
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading WorkSpaces Web Trust Store (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	certificates, err := findTrustStoreCertificates(ctx, conn, data.ID.ValueString())
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading WorkSpaces Web Trust Store (%s) certificates", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(data.flatten(ctx, trustStore, certificates)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *trustStoreResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data trustStoreResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().WorkSpacesWebClient(ctx)
+
+	trustStore, err := findTrustStoreByARN(ctx, conn, data.ID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading WorkSpaces Web Trust Store (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	certificates, err := findTrustStoreCertificates(ctx, conn, data.ID.ValueString())
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading WorkSpaces Web Trust Store (%s) certificates", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(data.flatten(ctx, trustStore, certificates)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *trustStoreResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new trustStoreResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().WorkSpacesWebClient(ctx)
+
+	if !new.CertificateList.Equal(old.CertificateList) {
+		oldCertificates, newCertificates := fwflex.ExpandFrameworkStringValueSet(ctx, old.CertificateList), fwflex.ExpandFrameworkStringValueSet(ctx, new.CertificateList)
+		add, del := newCertificates.Difference(oldCertificates), oldCertificates.Difference(newCertificates)
+
+		input := &workspacesweb.UpdateTrustStoreInput{
+			CertificatesToAdd: tfslices.ApplyToAll(add, func(v string) []byte {
+				return []byte(v)
+			}),
+			ClientToken:   aws.String(sdkid.UniqueId()),
+			TrustStoreArn: aws.String(new.ID.ValueString()),
+		}
+
+		// Certificates are removed by thumbprint.
+		if len(del) > 0 {
+			thumbprints, err := findTrustStoreCertificateThumbprints(ctx, conn, new.ID.ValueString(), del)
+
+			if err != nil {
+				response.Diagnostics.AddError(fmt.Sprintf("reading WorkSpaces Web Trust Store (%s) certificates", new.ID.ValueString()), err.Error())
+
+				return
+			}
+
+			input.CertificatesToDelete = thumbprints
+		}
+
+		_, err := conn.UpdateTrustStore(ctx, input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating WorkSpaces Web Trust Store (%s)", new.ID.ValueString()), err.Error())
+
+			return
+		}
+	}
+
+	trustStore, err := findTrustStoreByARN(ctx, conn, new.ID.ValueString())
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading WorkSpaces Web Trust Store (%s)", new.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	certificates, err := findTrustStoreCertificates(ctx, conn, new.ID.ValueString())
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading WorkSpaces Web Trust Store (%s) certificates", new.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(new.flatten(ctx, trustStore, certificates)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *trustStoreResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data trustStoreResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().WorkSpacesWebClient(ctx)
+
+	_, err := conn.DeleteTrustStore(ctx, &workspacesweb.DeleteTrustStoreInput{
+		TrustStoreArn: aws.String(data.ID.ValueString()),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting WorkSpaces Web Trust Store (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func (r *trustStoreResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	r.SetTagsAll(ctx, request, response)
+}
+
+func findTrustStoreByARN(ctx context.Context, conn *workspacesweb.Client, arn string) (*awstypes.TrustStore, error) {
+	input := &workspacesweb.GetTrustStoreInput{
+		TrustStoreArn: aws.String(arn),
+	}
+
+	output, err := conn.GetTrustStore(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.TrustStore == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.TrustStore, nil
+}
+
+func findTrustStoreCertificates(ctx context.Context, conn *workspacesweb.Client, arn string) ([]awstypes.CertificateSummary, error) {
+	input := &workspacesweb.ListTrustStoreCertificatesInput{
+		TrustStoreArn: aws.String(arn),
+	}
+	var output []awstypes.CertificateSummary
+
+	pages := workspacesweb.NewListTrustStoreCertificatesPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.CertificateList...)
+	}
+
+	return output, nil
+}
+
+// findTrustStoreCertificateThumbprints returns the thumbprints of the trust store's certificates whose bodies match the specified PEM-encoded certificates.
+func findTrustStoreCertificateThumbprints(ctx context.Context, conn *workspacesweb.Client, arn string, certificates []string) ([]string, error) {
+	summaries, err := findTrustStoreCertificates(ctx, conn, arn)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var thumbprints []string
+
+	for _, v := range summaries {
+		thumbprint := aws.ToString(v.Thumbprint)
+		output, err := conn.GetTrustStoreCertificate(ctx, &workspacesweb.GetTrustStoreCertificateInput{
+			Thumbprint:    aws.String(thumbprint),
+			TrustStoreArn: aws.String(arn),
+		})
+
+		if err != nil {
+			return nil, err
+		}
+
+		if output.Certificate == nil {
+			continue
+		}
+
+		if tfslices.Any(certificates, func(v string) bool {
+			return certificateBodiesEqual(output.Certificate.Body, v)
+		}) {
+			thumbprints = append(thumbprints, thumbprint)
+		}
+	}
+
+	return thumbprints, nil
+}
+
+// certificateBodiesEqual returns whether a certificate body returned by the API, which may be PEM- or DER-encoded,
+// is the same certificate as the specified PEM-encoded certificate.
+func certificateBodiesEqual(body []byte, certificate string) bool {
+	if strings.TrimSpace(string(body)) == strings.TrimSpace(certificate) {
+		return true
+	}
+
+	block, _ := pem.Decode([]byte(certificate))
+
+	if block == nil {
+		return false
+	}
+
+	if string(block.Bytes) == string(body) {
+		return true
+	}
+
+	if v, _ := pem.Decode(body); v != nil {
+		return string(block.Bytes) == string(v.Bytes)
+	}
+
+	return false
+}
+
+type trustStoreResourceModel struct {
+	AssociatedPortalARNs fwtypes.ListValueOf[types.String]                        `tfsdk:"associated_portal_arns"`
+	Certificates         fwtypes.ListNestedObjectValueOf[certificateSummaryModel] `tfsdk:"certificate"`
+	CertificateList      fwtypes.SetValueOf[types.String]                         `tfsdk:"certificate_list"`
+	ID                   types.String                                             `tfsdk:"id"`
+	Tags                 types.Map                                                `tfsdk:"tags"`
+	TagsAll              types.Map                                                `tfsdk:"tags_all"`
+	TrustStoreARN        types.String                                             `tfsdk:"arn"`
+}
+
+// flatten sets the model's values from the API objects.
+// Certificate bodies aren't read back; the certificate summaries are exposed instead.
+func (data *trustStoreResourceModel) flatten(ctx context.Context, trustStore *awstypes.TrustStore, certificates []awstypes.CertificateSummary) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	diags.Append(fwflex.Flatten(ctx, trustStore, data)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	diags.Append(fwflex.Flatten(ctx, certificates, &data.Certificates)...)
+
+	return diags
+}
+
+type certificateSummaryModel struct {
+	Issuer         types.String      `tfsdk:"issuer"`
+	NotValidAfter  timetypes.RFC3339 `tfsdk:"not_valid_after"`
+	NotValidBefore timetypes.RFC3339 `tfsdk:"not_valid_before"`
+	Subject        types.String      `tfsdk:"subject"`
+	Thumbprint     types.String      `tfsdk:"thumbprint"`
+}
@@ -16,6 +16,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
 	tfjson "github.com/hashicorp/terraform-provider-aws/internal/json"
+	"gopkg.in/yaml.v3"
 )
 
 // SuppressEquivalentPolicyDiffs returns a difference suppression function that compares
@@ -84,6 +85,37 @@ func SuppressEquivalentJSONOrYAMLDiffs(k, old, new string, d *schema.ResourceDat
 	return normalizedOld == normalizedNew
 }
 
+// JSONOrYAMLStringsEquivalent returns whether two JSON or YAML documents are semantically equivalent,
+// ignoring differences in formatting such as whitespace, comments, quoting and mapping key order.
+func JSONOrYAMLStringsEquivalent(s1, s2 string) bool {
+	normalized1, err := NormalizeJSONOrYAMLString(s1)
+	if err != nil {
+		return false
+	}
+
+	normalized2, err := NormalizeJSONOrYAMLString(s2)
+	if err != nil {
+		return false
+	}
+
+	if normalized1 == normalized2 {
+		return true
+	}
+
+	// JSON is a subset of YAML, so both documents can be compared as YAML.
+	var v1, v2 interface{}
+
+	if err := yaml.Unmarshal([]byte(normalized1), &v1); err != nil {
+		return false
+	}
+
+	if err := yaml.Unmarshal([]byte(normalized2), &v2); err != nil {
+		return false
+	}
+
+	return reflect.DeepEqual(v1, v2)
+}
+
 func NormalizeJSONOrYAMLString(templateString interface{}) (string, error) {
 	if v, ok := templateString.(string); ok {
 		templateString = strings.ReplaceAll(v, "\r\n", "\n")
@@ -628,6 +628,102 @@ Outputs:
 	}
 }
 
+func TestJSONOrYAMLStringsEquivalent(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		description string
+		equivalent  bool
+		s1          string
+		s2          string
+	}{
+		{
+			description: `JSON whitespace`,
+			equivalent:  true,
+			s1:          `{"a": {"b": [1, 2]}}`,
+			s2:          `{"a":{"b":[1,2]}}`,
+		},
+		{
+			description: `JSON change`,
+			equivalent:  false,
+			s1:          `{"a": {"b": [1, 2]}}`,
+			s2:          `{"a": {"b": [2, 1]}}`,
+		},
+		{
+			description: `YAML whitespace and comments`,
+			equivalent:  true,
+			s1: `
+version: "2"
+log-pipeline:
+  source:
+    http:
+      path: "/log/ingest"
+
+  sink:
+    - stdout: {}
+`,
+			s2: `version: "2"
+# Log pipeline.
+log-pipeline:
+    source:
+        http:
+            path: /log/ingest
+    sink:
+    - stdout: {}
+`,
+		},
+		{
+			description: `YAML key order`,
+			equivalent:  true,
+			s1: `
+a: 1
+b: 2
+`,
+			s2: `
+b: 2
+a: 1
+`,
+		},
+		{
+			description: `YAML change`,
+			equivalent:  false,
+			s1: `
+log-pipeline:
+  source:
+    http:
+      path: "/log/ingest"
+`,
+			s2: `
+log-pipeline:
+  source:
+    http:
+      path: "/logs/ingest"
+`,
+		},
+		{
+			description: `YAML and equivalent JSON`,
+			equivalent:  true,
+			s1: `
+a:
+  b: [1, 2]
+`,
+			s2: `{"a": {"b": [1, 2]}}`,
+		},
+		{
+			description: `invalid YAML`,
+			equivalent:  false,
+			s1:          `a: [`,
+			s2:          `a: [`,
+		},
+	}
+
+	for _, tc := range testCases {
+		if got := JSONOrYAMLStringsEquivalent(tc.s1, tc.s2); got != tc.equivalent {
+			t.Errorf("test case (%s): got %t, want %t", tc.description, got, tc.equivalent)
+		}
+	}
+}
+
 func TestLegacyPolicyNormalize(t *testing.T) {
 	t.Parallel()
 
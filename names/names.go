@@ -59,14 +59,18 @@ const (
 	DeviceFarmEndpointID                 = "devicefarm"
 	DevOpsGuruEndpointID                 = "devops-guru"
 	DLMEndpointID                        = "dlm"
+	DRSEndpointID                        = "drs"
 	ECREndpointID                        = "api.ecr"
 	EKSEndpointID                        = "eks"
 	EMREndpointID                        = "elasticmapreduce"
 	EventsEndpointID                     = "events"
 	EvidentlyEndpointID                  = "evidently"
 	FMSEndpointID                        = "fms"
+	HealthLakeEndpointID                 = "healthlake"
 	IdentityStoreEndpointID              = "identitystore"
 	Inspector2EndpointID                 = "inspector2"
+	IoTAnalyticsEndpointID               = "iotanalytics"
+	IoTEventsEndpointID                  = "iotevents"
 	IVSChatEndpointID                    = "ivschat"
 	KendraEndpointID                     = "kendra"
 	KMSEndpointID                        = "kms"